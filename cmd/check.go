@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github/pfinal/pf_ruler/pkg/platform"
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+// checkResult 单个平台的检查结果
+type checkResult struct {
+	Platform string
+
+	// 缺失、内容过期或应被删除的文件
+	Stale []string
+
+	Err error
+}
+
+// checkPlatform 在内存中生成平台的输出并与磁盘上的文件比较，打印需要更新的文件的统一 diff
+// 不写入、不删除任何文件，返回缺失、内容过期或应被删除的文件
+func checkPlatform(adapter platform.PlatformAdapter, ruleSet *rules.RuleSet, outputPath string) ([]string, error) {
+	output, err := renderPlatform(adapter, ruleSet, outputPath)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := compareOutputFiles(output.Files)
+	if err != nil {
+		return nil, err
+	}
+
+	var stale []string
+	paths := make([]string, 0, len(output.Files))
+	for _, change := range changes {
+		paths = append(paths, change.File.Path)
+		if diff := change.Diff(); diff != "" {
+			printDiff(diff)
+			stale = append(stale, change.File.Path)
+		}
+	}
+
+	// 之前生成、本次不再输出的文件在重新生成时会被删除
+	removed, err := findStaleFiles(output.Owned, paths)
+	if err != nil {
+		return stale, fmt.Errorf("检查过期文件失败: %w", err)
+	}
+	for _, path := range removed {
+		diff, err := removalDiff(path)
+		if err != nil {
+			return stale, err
+		}
+		printDiff(diff)
+		stale = append(stale, path)
+	}
+
+	return stale, nil
+}
+
+// diffPath 返回统一 diff 中带 a/、b/ 前缀的文件路径，与 git diff 的格式一致
+func diffPath(prefix, path string) string {
+	return prefix + "/" + filepath.ToSlash(filepath.Clean(path))
+}
+
+// printCheckSummary 打印各平台的检查结果，返回需要更新或检查失败的平台数量
+func printCheckSummary(results []checkResult) int {
+	outdated := 0
+
+	cyanBold("📋 检查结果汇总：")
+	for _, result := range results {
+		switch {
+		case result.Err != nil:
+			outdated++
+			red(fmt.Sprintf("  ❌ %-10s %v", result.Platform, result.Err))
+		case len(result.Stale) > 0:
+			outdated++
+			yellow(fmt.Sprintf("  ⚠️  %-10s %d 个文件需要更新", result.Platform, len(result.Stale)))
+		default:
+			green(fmt.Sprintf("  ✅ %-10s 已是最新", result.Platform))
+		}
+	}
+
+	return outdated
+}
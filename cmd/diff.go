@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// diffContext 统一 diff 中每处修改前后保留的上下文行数
+	diffContext = 3
+
+	// diffMaxCells 逐行比较的最大规模（行数乘积），超出时整体显示为删除旧内容、添加新内容
+	diffMaxCells = 4 << 20
+)
+
+// diffLine 统一 diff 中的一行，op 为 ' '（未修改）、'-'（删除）或 '+'（添加）
+type diffLine struct {
+	op   byte
+	text string
+}
+
+// unifiedDiff 返回旧内容到新内容的统一 diff，内容相同时返回空字符串
+// 文件不存在时对应的名称传 /dev/null
+func unifiedDiff(oldName, newName string, oldData, newData []byte) string {
+	if string(oldData) == string(newData) {
+		return ""
+	}
+
+	lines := diffLines(splitLines(string(oldData)), splitLines(string(newData)))
+
+	var out strings.Builder
+	out.WriteString(fmt.Sprintf("--- %s\n+++ %s\n", oldName, newName))
+	for _, hunk := range diffHunks(lines) {
+		writeHunk(&out, lines, hunk[0], hunk[1])
+	}
+	return out.String()
+}
+
+// splitLines 按行拆分内容，每行保留行尾的换行符，用于区分末尾是否有换行
+func splitLines(content string) []string {
+	lines := strings.SplitAfter(content, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines 基于最长公共子序列逐行比较，返回由未修改、删除和添加的行组成的序列
+func diffLines(a, b []string) []diffLine {
+	// 去除相同的开头和结尾，只比较中间部分
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var lines []diffLine
+	for _, line := range a[:prefix] {
+		lines = append(lines, diffLine{' ', line})
+	}
+	lines = append(lines, diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, line := range a[len(a)-suffix:] {
+		lines = append(lines, diffLine{' ', line})
+	}
+	return lines
+}
+
+// diffMiddle 比较去除相同开头和结尾后的部分
+func diffMiddle(a, b []string) []diffLine {
+	var lines []diffLine
+
+	// 规模过大时不逐行比较，整体替换
+	if len(a)*len(b) > diffMaxCells {
+		for _, line := range a {
+			lines = append(lines, diffLine{'-', line})
+		}
+		for _, line := range b {
+			lines = append(lines, diffLine{'+', line})
+		}
+		return lines
+	}
+
+	// lcs[i][j] 为 a[i:] 和 b[j:] 的最长公共子序列长度
+	lcs := make([][]int32, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, diffLine{' ', a[i]})
+			i++
+			j++
+		case j >= len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, diffLine{'-', a[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{'+', b[j]})
+			j++
+		}
+	}
+	return lines
+}
+
+// diffHunks 将修改过的行连同上下文划分为区块，返回各区块在 lines 中的起止位置（左闭右开）
+// 两处修改之间的未修改行不超过两倍上下文时合并为一个区块
+func diffHunks(lines []diffLine) [][2]int {
+	var hunks [][2]int
+	for i, line := range lines {
+		if line.op == ' ' {
+			continue
+		}
+
+		start := i - diffContext
+		if start < 0 {
+			start = 0
+		}
+		end := i + diffContext + 1
+		if end > len(lines) {
+			end = len(lines)
+		}
+
+		if n := len(hunks); n > 0 && start <= hunks[n-1][1] {
+			hunks[n-1][1] = end
+		} else {
+			hunks = append(hunks, [2]int{start, end})
+		}
+	}
+	return hunks
+}
+
+// writeHunk 写入一个区块，包括 @@ -旧起始行,行数 +新起始行,行数 @@ 标题
+func writeHunk(out *strings.Builder, lines []diffLine, start, end int) {
+	oldStart, newStart := 0, 0
+	for _, line := range lines[:start] {
+		if line.op != '+' {
+			oldStart++
+		}
+		if line.op != '-' {
+			newStart++
+		}
+	}
+
+	oldCount, newCount := 0, 0
+	for _, line := range lines[start:end] {
+		if line.op != '+' {
+			oldCount++
+		}
+		if line.op != '-' {
+			newCount++
+		}
+	}
+
+	// 区块为空的一侧以前一行的行号表示
+	if oldCount > 0 {
+		oldStart++
+	}
+	if newCount > 0 {
+		newStart++
+	}
+
+	out.WriteString(fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", oldStart, oldCount, newStart, newCount))
+	for _, line := range lines[start:end] {
+		out.WriteByte(line.op)
+		out.WriteString(line.text)
+		if !strings.HasSuffix(line.text, "\n") {
+			out.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// printDiff 按行着色打印统一 diff：文件头加粗，区块标题为青色，删除的行为红色，添加的行为绿色
+// 输出不是终端时 fatih/color 自动去除颜色
+func printDiff(diff string) {
+	for _, line := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "--- "), strings.HasPrefix(line, "+++ "):
+			bold(line)
+		case strings.HasPrefix(line, "@@"):
+			cyan(line)
+		case strings.HasPrefix(line, "-"):
+			red(line)
+		case strings.HasPrefix(line, "+"):
+			green(line)
+		default:
+			fmt.Println(line)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// numberedLines 返回 1 到 n 的行，replace 中的行号替换为指定内容
+func numberedLines(n int, replace map[int]string) string {
+	var content strings.Builder
+	for i := 1; i <= n; i++ {
+		line, ok := replace[i]
+		if !ok {
+			line = strconv.Itoa(i)
+		}
+		content.WriteString(line + "\n")
+	}
+	return content.String()
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name             string
+		oldName, newName string
+		old, new         string
+		want             string
+	}{
+		{
+			name:    "内容相同",
+			oldName: "a/x", newName: "b/x",
+			old: "a\nb\n", new: "a\nb\n",
+			want: "",
+		},
+		{
+			name:    "均为空",
+			oldName: "/dev/null", newName: "b/x",
+			want: "",
+		},
+		{
+			name:    "新建文件",
+			oldName: "/dev/null", newName: "b/x",
+			new:  "a\nb\n",
+			want: "--- /dev/null\n+++ b/x\n@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		{
+			name:    "删除文件",
+			oldName: "a/x", newName: "/dev/null",
+			old:  "a\nb\n",
+			want: "--- a/x\n+++ /dev/null\n@@ -1,2 +0,0 @@\n-a\n-b\n",
+		},
+		{
+			name:    "末尾添加换行",
+			oldName: "a/x", newName: "b/x",
+			old: "a\nb", new: "a\nb\n",
+			want: "--- a/x\n+++ b/x\n@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+b\n",
+		},
+		{
+			name:    "末尾删除换行",
+			oldName: "a/x", newName: "b/x",
+			old: "a\n", new: "a",
+			want: "--- a/x\n+++ b/x\n@@ -1,1 +1,1 @@\n-a\n+a\n\\ No newline at end of file\n",
+		},
+		{
+			name:    "单个区块",
+			oldName: "a/x", newName: "b/x",
+			old: numberedLines(10, nil), new: numberedLines(10, map[int]string{5: "five"}),
+			want: "--- a/x\n+++ b/x\n@@ -2,7 +2,7 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n",
+		},
+		{
+			name:    "相距较近的修改合并为一个区块",
+			oldName: "a/x", newName: "b/x",
+			old: numberedLines(12, nil), new: numberedLines(12, map[int]string{2: "x", 8: "y"}),
+			want: "--- a/x\n+++ b/x\n@@ -1,11 +1,11 @@\n 1\n-2\n+x\n 3\n 4\n 5\n 6\n 7\n-8\n+y\n 9\n 10\n 11\n",
+		},
+		{
+			name:    "多个区块",
+			oldName: "a/x", newName: "b/x",
+			old: numberedLines(20, nil), new: numberedLines(20, map[int]string{2: "x", 18: "y"}),
+			want: "--- a/x\n+++ b/x\n" +
+				"@@ -1,5 +1,5 @@\n 1\n-2\n+x\n 3\n 4\n 5\n" +
+				"@@ -15,6 +15,6 @@\n 15\n 16\n 17\n-18\n+y\n 19\n 20\n",
+		},
+		{
+			name:    "插入和删除行",
+			oldName: "a/x", newName: "b/x",
+			old: "a\nb\nc\n", new: "a\nc\nd\n",
+			want: "--- a/x\n+++ b/x\n@@ -1,3 +1,3 @@\n a\n-b\n c\n+d\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := unifiedDiff(tt.oldName, tt.newName, []byte(tt.old), []byte(tt.new))
+			if got != tt.want {
+				t.Errorf("unifiedDiff() =\n%s\n期望\n%s", got, tt.want)
+			}
+		})
+	}
+}
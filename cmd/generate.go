@@ -3,7 +3,8 @@ package cmd
 import (
 	"fmt"
 	"os"
-	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github/pfinal/pf_ruler/pkg/platform"
@@ -13,9 +14,23 @@ import (
 var (
 	// 命令标志
 	platformFlag string
+	outputFlag   string
 	forceFlag    bool
+	langFlag     string
+	checkFlag    bool
+
+	dryRunFlag      bool
+	diffFlag        bool
+	interactiveFlag bool
 )
 
+// platformResult 单个平台的生成结果
+type platformResult struct {
+	Platform    string
+	OutputPaths []string
+	Err         error
+}
+
 // generateCmd represents the generate command
 var generateCmd = &cobra.Command{
 	Use:   "generate",
@@ -23,35 +38,149 @@ var generateCmd = &cobra.Command{
 	Long: `加载 .ruler 目录中的统一规则，根据用户指定的 AI 编辑器平台，
 自动转换为该平台的原生规则格式，并输出到对应目录。
 
+--platform 支持单个平台、逗号分隔的多个平台或 all（全部已注册平台）。
+
+配置优先级（从高到低）：
+  1. 命令行参数：--platform、--output、--lang
+  2. 环境变量：PF_RULER_PLATFORM、PF_RULER_LANG、PF_RULER_<PLATFORM>_OUTPUT（如 PF_RULER_TRAE_OUTPUT）
+  3. .ruler/config.yaml：default_platform、output_language、platforms.<name>.output
+  4. 内置默认值：tech_stack.yaml 中 ai_editors 列出的编辑器，均不可用时为 trae；
+     输出语言 trae 为 zh-CN，其他平台为 en
+
+--check 在内存中生成全部目标平台的文件并与现有文件比较，以统一 diff 输出缺失、过期
+或应删除的文件，不写入任何文件；存在需要更新的文件时以非零状态退出。
+
+--dry-run 只打印将新建、覆盖和删除的文件，--diff 以彩色统一 diff 显示与现有文件的差异，
+--interactive 在终端中逐个显示差异并确认是否写入（确认覆盖的文件不需要 --force）。
+
+--lang 指定生成文件中标题、标签和说明文字的语言（zh-CN 或 en），
+规则带有该语言的版本（如 <!-- content_en -->）时使用该版本的内容。
+
 支持平台：
-  - trae: 生成 .trae/rules/project_rules.md 文件
-  - cursor: 生成 .cursor/rules.json 文件
+  - trae: 生成 .trae/rules/project_rules.md 文件（可选导出用户规则、拆分为多个文件）
+  - cursor: 生成 .cursor/rules/*.mdc 文件（legacy 模式生成 .cursorrules 文件）
+  - claude: 生成 CLAUDE.md 文件（可选拆分为 .claude/rules/*.md 并通过 @path 导入）
+  - copilot: 生成 .github/copilot-instructions.md 及按路径生效的 .github/instructions/*.instructions.md
+  - windsurf: 生成 .windsurf/rules/*.md 文件（legacy 模式生成 .windsurfrules 文件）
+  - agents: 生成 AGENTS.md 文件（Codex、opencode、Jules 等），限定在子目录的规则写入 <subdir>/AGENTS.md
+  - cline: 生成 .clinerules/ 目录下按优先级编号的规则文件
+  - roo: 生成 .roo/rules/ 及按 mode:<mode> 标签划分的 .roo/rules-<mode>/ 规则文件
+  - gemini: 生成 GEMINI.md 文件（较大的分组拆分为 @file.md 导入，可选更新 .gemini/settings.json）
+  - aider: 生成 CONVENTIONS.md 文件，并将其加入 .aider.conf.yml 的 read 列表
+  - kiro: 生成 .kiro/steering/*.md 文件（product.md、tech.md、structure.md 及按需加载的规则）
+  - jetbrains: 生成 JetBrains AI Assistant 的 .aiassistant/rules/*.md 文件
+  - junie: 生成 JetBrains Junie 的 .junie/guidelines.md 文件
+  - continue: 生成 .continue/rules/*.md 文件（带 name、globs、alwaysApply、description front matter）
+  - amazonq: 生成 Amazon Q Developer 的 .amazonq/rules/*.md 文件
+  - augment: 生成 Augment Code 的 .augment/rules/*.md 文件（带 type: always|auto|manual front matter）
+  - zed: 生成 .rules 文件
+  - warp: 生成 WARP.md 文件
+  - goose: 生成 .goosehints 文件
+  - openhands: 生成 .openhands/microagents/repo.md 及按关键词触发的 knowledge 微代理
+  - .ruler/platforms/<name>.yaml 中声明的自定义平台
+  - .ruler/plugins/ 或 PATH 中名为 pf_ruler-adapter-<name> 的外部插件
 
 示例：
-  pf_ruler generate                    # 生成默认平台规则
-  pf_ruler generate --platform=cursor  # 生成指定平台规则
-  pf_ruler generate --platform=cursor --force  # 强制覆盖现有文件
+  pf_ruler generate                           # 为所有配置的编辑器生成规则
+  pf_ruler generate --platform=cursor         # 生成指定平台规则
+  pf_ruler generate --platform=trae,cursor    # 生成多个平台规则
+  pf_ruler generate --platform=all --force    # 生成全部平台规则并强制覆盖
+  pf_ruler generate --platform=trae --lang=en # 生成英文的 Trae 规则
+  pf_ruler generate --check                   # 检查生成的文件是否为最新（适用于 CI）
+  pf_ruler generate --dry-run --diff          # 预览将写入的文件及差异，不写入任何文件
+  pf_ruler generate -i                        # 逐个查看差异并确认是否写入
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		// 1. 平台参数校验
-		if err := validatePlatform(); err != nil {
-			redBold("❌ 平台参数错误：", err)
+		// 1. 加载配置
+		config, err := loadRulerConfig()
+		if err != nil {
+			redBold("❌ 加载配置失败：", err)
+			os.Exit(1)
+		}
+
+		lang, err := resolveLanguage(config)
+		if err != nil {
+			redBold("❌ 语言参数错误：", err)
 			os.Exit(1)
 		}
 
-		// 2. 加载统一规则
-		ruleSet, err := loadUnifiedRules()
+		// 2. 加载统一规则（只加载一次，供所有平台共用）
+		ruleSet, err := loadUnifiedRules(config)
 		if err != nil {
 			redBold("❌ 加载规则失败：", err)
 			os.Exit(1)
 		}
+		if lang != "" {
+			ruleSet = ruleSet.Localize(lang)
+		}
+
+		// 3. 解析目标平台
+		registry, err := newPlatformRegistry()
+		if err != nil {
+			redBold("❌ 加载自定义平台失败：", err)
+			os.Exit(1)
+		}
+		if err := configurePlatforms(registry, config); err != nil {
+			redBold("❌ 配置文件错误：", err)
+			os.Exit(1)
+		}
 
-		// 3. 跨平台规则转换
-		if err := convertAndOutput(ruleSet); err != nil {
-			redBold("❌ 规则转换失败：", err)
+		platforms, err := resolvePlatforms(registry, config, ruleSet.Metadata)
+		if err != nil {
+			redBold("❌ 平台参数错误：", err)
 			os.Exit(1)
 		}
 
+		if outputFlag != "" {
+			if len(platforms) != 1 {
+				redBold("❌ 平台参数错误：--output 只能在生成单个平台时使用")
+				os.Exit(1)
+			}
+			config.SetOutput(platforms[0], outputFlag)
+		}
+
+		// 4. --check：在内存中生成并与现有文件比较，不写入任何文件
+		if checkFlag {
+			results := make([]checkResult, 0, len(platforms))
+			for _, name := range platforms {
+				adapter, _ := registry.Get(name)
+				stale, err := checkPlatform(adapter, ruleSet, config.OutputPath(name))
+				results = append(results, checkResult{Platform: name, Stale: stale, Err: err})
+			}
+
+			if outdated := printCheckSummary(results); outdated > 0 {
+				redBold(fmt.Sprintf("❌ %d 个平台的规则文件需要更新，请运行 pf_ruler generate 重新生成", outdated))
+				os.Exit(1)
+			}
+
+			greenBold("✅ 规则文件均为最新！")
+			return
+		}
+
+		// 5. 逐个平台转换并输出
+		interactive := interactiveFlag && !dryRunFlag
+		if interactive && !isInteractive() {
+			yellowBold("⚠️  当前不在终端中运行，已忽略 --interactive")
+			interactive = false
+		}
+
+		results := make([]platformResult, 0, len(platforms))
+		for _, name := range platforms {
+			adapter, _ := registry.Get(name)
+			outputPaths, err := convertAndOutput(adapter, ruleSet, config.OutputPath(name), interactive)
+			results = append(results, platformResult{Platform: name, OutputPaths: outputPaths, Err: err})
+		}
+
+		// 6. 输出汇总
+		if failed := printGenerateSummary(results); failed > 0 {
+			redBold(fmt.Sprintf("❌ %d 个平台规则生成失败", failed))
+			os.Exit(1)
+		}
+
+		if dryRunFlag {
+			greenBold("✅ 预览完成，未写入任何文件")
+			return
+		}
 		greenBold("✅ 规则生成完成！")
 	},
 }
@@ -61,44 +190,234 @@ func init() {
 	rootCmd.AddCommand(generateCmd)
 
 	// 添加标志
-	generateCmd.Flags().StringVarP(&platformFlag, "platform", "p", "", "目标平台 (trae, cursor)")
+	generateCmd.Flags().StringVarP(&platformFlag, "platform", "p", "", "目标平台，支持逗号分隔或 all (trae, cursor, claude, copilot, windsurf, agents, cline, roo, gemini, aider, kiro, jetbrains, junie, continue, amazonq, augment, zed, warp, goose, openhands)")
+	generateCmd.Flags().StringVarP(&outputFlag, "output", "o", "", "输出文件路径（仅生成单个平台时可用）")
 	generateCmd.Flags().BoolVarP(&forceFlag, "force", "f", false, "强制覆盖现有文件")
+	generateCmd.Flags().BoolVar(&dryRunFlag, "dry-run", false, "只打印将写入和删除的文件，不写入任何文件")
+	generateCmd.Flags().BoolVar(&diffFlag, "diff", false, "写入前以彩色统一 diff 显示与现有文件的差异（可与 --dry-run 一起使用）")
+	generateCmd.Flags().BoolVarP(&interactiveFlag, "interactive", "i", false, "在终端中逐个显示差异并确认是否写入或删除文件")
+	generateCmd.Flags().BoolVar(&checkFlag, "check", false, "只检查生成的文件是否为最新，输出差异且不写入任何文件，需要更新时以非零状态退出")
+	generateCmd.Flags().StringVar(&langFlag, "lang", "", "生成文件的输出语言 (zh-CN, en)，默认 trae 为 zh-CN、其他平台为 en")
 }
 
-// validatePlatform 验证平台参数
-func validatePlatform() error {
-	// 如果没有指定平台，使用配置文件中的默认平台
-	if platformFlag == "" {
-		configPath := filepath.Join(".ruler", "config.yaml")
-		if _, err := os.Stat(configPath); err == nil {
-			// 这里可以读取配置文件获取默认平台
-			// 暂时使用 trae 作为默认平台
-			platformFlag = "trae"
-		} else {
-			platformFlag = "trae" // 默认使用 trae
-		}
+// resolveLanguage 返回输出语言，--lang 优先于 PF_RULER_LANG 和 config.yaml 的 output_language
+// 均未指定时返回空字符串，由各平台使用默认语言
+func resolveLanguage(config *rules.Config) (string, error) {
+	lang := config.OutputLanguage
+	if langFlag != "" {
+		lang = langFlag
+	}
+	if lang == "" {
+		return "", nil
 	}
+	return rules.NormalizeLanguage(lang)
+}
 
-	// 检查平台是否支持
-	supportedPlatforms := []string{"trae", "cursor"}
-	for _, platform := range supportedPlatforms {
-		if platformFlag == platform {
-			return nil
+// newPlatformRegistry 创建并注册所有内置平台适配器、.ruler/platforms 中声明的自定义平台，
+// 以及 .ruler/plugins 和 PATH 中的 pf_ruler-adapter-<name> 插件
+func newPlatformRegistry() (*platform.PlatformRegistry, error) {
+	registry := platform.NewPlatformRegistry()
+	registry.Register(platform.NewTraeAdapter())
+	registry.Register(platform.NewCursorAdapter())
+	registry.Register(platform.NewClaudeAdapter())
+	registry.Register(platform.NewCopilotAdapter())
+	registry.Register(platform.NewWindsurfAdapter())
+	registry.Register(platform.NewAgentsAdapter())
+	registry.Register(platform.NewClineAdapter())
+	registry.Register(platform.NewRooAdapter())
+	registry.Register(platform.NewGeminiAdapter())
+	registry.Register(platform.NewAiderAdapter())
+	registry.Register(platform.NewKiroAdapter())
+	registry.Register(platform.NewJetBrainsAdapter())
+	registry.Register(platform.NewJunieAdapter())
+	registry.Register(platform.NewContinueAdapter())
+	registry.Register(platform.NewAmazonQAdapter())
+	registry.Register(platform.NewAugmentAdapter())
+	registry.Register(platform.NewZedAdapter())
+	registry.Register(platform.NewWarpAdapter())
+	registry.Register(platform.NewGooseAdapter())
+	registry.Register(platform.NewOpenHandsAdapter())
+
+	customAdapters, err := platform.LoadCustomPlatforms(".ruler")
+	if err != nil {
+		return nil, err
+	}
+	for _, adapter := range customAdapters {
+		if _, exists := registry.Get(adapter.Name()); exists {
+			return nil, fmt.Errorf("自定义平台 \"%s\" 与内置平台重名", adapter.Name())
 		}
+		registry.Register(adapter)
+	}
+
+	// 外部插件只在被选中时执行，与已有平台重名的插件不会注册
+	for _, plugin := range registry.RegisterPlugins(".ruler") {
+		yellowBold(fmt.Sprintf("⚠️  插件 %s 与已有平台重名，已忽略", plugin.Executable()))
 	}
 
-	return fmt.Errorf("不支持的平台 \"%s\"，当前支持：%v", platformFlag, supportedPlatforms)
+	return registry, nil
 }
 
-// loadUnifiedRules 加载统一规则
-func loadUnifiedRules() (*rules.RuleSet, error) {
+// loadRulerConfig 加载 .ruler/config.yaml 并应用环境变量覆盖
+func loadRulerConfig() (*rules.Config, error) {
 	// 检查 .ruler 目录是否存在
 	if _, err := os.Stat(".ruler"); os.IsNotExist(err) {
 		return nil, fmt.Errorf(".ruler 目录不存在，请先运行 pf_ruler init 命令")
 	}
 
+	config, err := rules.LoadConfig(".ruler")
+	if err != nil {
+		return nil, err
+	}
+
+	config.ApplyEnv()
+	return config, nil
+}
+
+// configurePlatforms 校验配置文件中的平台名称，并将平台选项应用到对应适配器
+func configurePlatforms(registry *platform.PlatformRegistry, config *rules.Config) error {
+	names := make([]string, 0, len(config.Platforms))
+	for name := range config.Platforms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		platformConfig := config.Platforms[name]
+		adapter, exists := registry.Get(name)
+		if !exists && config.OutputEnv(name) != "" {
+			yellowBold(fmt.Sprintf("⚠️  环境变量 %s 对应的平台 \"%s\" 不存在，已忽略", config.OutputEnv(name), name))
+			continue
+		}
+		if !exists {
+			return fmt.Errorf("platforms 中的平台 \"%s\" 不存在，当前支持：%v", name, registry.ListSupported())
+		}
+
+		if len(platformConfig.Options) == 0 {
+			continue
+		}
+
+		configurable, ok := adapter.(platform.Configurable)
+		if !ok {
+			return fmt.Errorf("平台 \"%s\" 不支持 options 配置", name)
+		}
+		if err := configurable.Configure(platformConfig.Options); err != nil {
+			return fmt.Errorf("platforms.%s.options 无效: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// resolvePlatforms 解析需要生成的平台列表
+// 依次使用 --platform 参数、default_platform 配置；均未指定时使用 ai_editors 中的编辑器，
+// 均不可用时回退到 trae
+func resolvePlatforms(registry *platform.PlatformRegistry, config *rules.Config, metadata rules.Metadata) ([]string, error) {
+	supported := registry.ListSupported()
+
+	requested := platformFlag
+	if requested == "" {
+		requested = config.DefaultPlatform
+		warnLegacyDefaultPlatform(registry, config, metadata)
+	}
+
+	if requested == "" {
+		platforms := editorPlatforms(registry, metadata, true)
+		if len(platforms) == 0 {
+			platforms = []string{"trae"}
+		}
+		return platforms, nil
+	}
+
+	if strings.TrimSpace(requested) == platform.AllPlatforms {
+		return supported, nil
+	}
+
+	var platforms []string
+	for _, name := range strings.Split(requested, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if _, exists := registry.Get(name); !exists {
+			return nil, fmt.Errorf("不支持的平台 \"%s\"，当前支持：%v", name, supported)
+		}
+		platforms = appendUnique(platforms, name)
+	}
+
+	if len(platforms) == 0 {
+		return nil, fmt.Errorf("未指定有效平台，当前支持：%v", supported)
+	}
+
+	return platforms, nil
+}
+
+// editorPlatforms 返回 ai_editors 中的编辑器对应的平台，warn 为 true 时提示没有适配器的编辑器
+func editorPlatforms(registry *platform.PlatformRegistry, metadata rules.Metadata, warn bool) []string {
+	var platforms []string
+	for _, editor := range metadata.AIEditors {
+		name := platform.PlatformForEditor(editor)
+		if _, exists := registry.Get(name); !exists {
+			if warn {
+				yellowBold(fmt.Sprintf("⚠️  编辑器 \"%s\" 暂无对应的平台适配器，已跳过", editor))
+			}
+			continue
+		}
+		platforms = appendUnique(platforms, name)
+	}
+	return platforms
+}
+
+// warnLegacyDefaultPlatform 旧版 pf_ruler init 会在 config.yaml 中写入 default_platform: trae，
+// 该配置会覆盖按 ai_editors 生成的默认行为；ai_editors 中还有其他编辑器时提示用户删除该配置项
+func warnLegacyDefaultPlatform(registry *platform.PlatformRegistry, config *rules.Config, metadata rules.Metadata) {
+	if os.Getenv(rules.EnvPlatform) != "" || strings.TrimSpace(config.DefaultPlatform) != "trae" {
+		return
+	}
+
+	platforms := editorPlatforms(registry, metadata, false)
+	if len(platforms) == 0 || (len(platforms) == 1 && platforms[0] == "trae") {
+		return
+	}
+
+	yellowBold(fmt.Sprintf("⚠️  config.yaml 中的 default_platform: trae 只生成 trae 规则（可能由旧版 pf_ruler init 写入）；"+
+		"删除该配置项即可为 ai_editors 中的全部编辑器（%s）生成规则", strings.Join(platforms, ", ")))
+}
+
+// appendUnique 追加不重复的元素
+func appendUnique(items []string, item string) []string {
+	for _, existing := range items {
+		if existing == item {
+			return items
+		}
+	}
+	return append(items, item)
+}
+
+// printGenerateSummary 输出各平台生成结果汇总，返回失败的平台数量
+func printGenerateSummary(results []platformResult) int {
+	failed := 0
+
+	cyanBold("📋 生成结果汇总：")
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			red(fmt.Sprintf("  ❌ %-10s %v", result.Platform, result.Err))
+			continue
+		}
+
+		summary := result.OutputPaths[0]
+		if len(result.OutputPaths) > 1 {
+			summary = fmt.Sprintf("%s 等 %d 个文件", summary, len(result.OutputPaths))
+		}
+		green(fmt.Sprintf("  ✅ %-10s %s", result.Platform, summary))
+	}
+
+	return failed
+}
+
+// loadUnifiedRules 加载统一规则
+func loadUnifiedRules(config *rules.Config) (*rules.RuleSet, error) {
 	// 创建规则加载器
-	loader := rules.NewFileLoader(".ruler")
+	loader := rules.NewFileLoader(".ruler").WithConfig(config)
 
 	// 加载所有规则
 	ruleSet, err := loader.LoadAllRules()
@@ -108,91 +427,110 @@ func loadUnifiedRules() (*rules.RuleSet, error) {
 
 	// 统计规则数量
 	totalRules := len(ruleSet.ProjectRules) + len(ruleSet.GlobalRules) + len(ruleSet.TemplateRules)
-	
+
 	if totalRules == 0 {
 		yellowBold("⚠️  未检测到任何规则文件，将使用默认模板")
 	} else {
-		greenBold(fmt.Sprintf("✅ 已加载规则（项目规则 %d 条 + 全局规则 %d 条）", 
+		greenBold(fmt.Sprintf("✅ 已加载规则（项目规则 %d 条 + 全局规则 %d 条）",
 			len(ruleSet.ProjectRules), len(ruleSet.GlobalRules)))
 	}
 
 	return ruleSet, nil
 }
 
-// convertAndOutput 转换并输出规则
-func convertAndOutput(ruleSet *rules.RuleSet) error {
-	// 创建平台注册表
-	registry := platform.NewPlatformRegistry()
-
-	// 注册支持的平台适配器
-	registry.Register(platform.NewTraeAdapter())
-	registry.Register(platform.NewCursorAdapter())
+// convertAndOutput 将规则转换为指定平台格式并输出，返回输出文件路径（主文件在前）
+// outputPath 不为空时替换适配器主文件的默认输出路径
+// --dry-run 时只打印将写入和删除的文件；--diff 时写入前打印差异；--interactive 时逐个确认文件
+func convertAndOutput(adapter platform.PlatformAdapter, ruleSet *rules.RuleSet, outputPath string, interactive bool) ([]string, error) {
+	// 转换规则
+	output, err := renderPlatform(adapter, ruleSet, outputPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// 获取目标平台适配器
-	adapter, exists := registry.Get(platformFlag)
-	if !exists {
-		return fmt.Errorf("平台适配器不存在: %s", platformFlag)
+	paths := make([]string, 0, len(output.Files))
+	for _, file := range output.Files {
+		paths = append(paths, file.Path)
 	}
 
-	// 转换规则
-	outputData, err := adapter.Convert(ruleSet)
+	// 之前生成、本次不再输出的文件
+	stale, err := findStaleFiles(output.Owned, paths)
 	if err != nil {
-		return fmt.Errorf("规则转换失败: %w", err)
+		return paths, fmt.Errorf("查找过期文件失败: %w", err)
 	}
 
-	greenBold(fmt.Sprintf("✅ 已完成 %s 规则格式转换", platformFlag))
+	files, force := output.Files, forceFlag
+	if dryRunFlag || diffFlag || interactive {
+		changes, err := compareOutputFiles(output.Files)
+		if err != nil {
+			return nil, err
+		}
 
-	// 确保输出目录存在
-	if err := ensureOutputDirectory(adapter); err != nil {
-		return fmt.Errorf("创建输出目录失败: %w", err)
+		switch {
+		case dryRunFlag:
+			return paths, previewOutput(adapter.Name(), changes, stale)
+		case interactive:
+			// 逐个确认后的文件直接覆盖，不再需要 --force
+			if files, err = confirmOutputFiles(changes); err != nil {
+				return nil, err
+			}
+			if stale, err = confirmRemovals(stale); err != nil {
+				return nil, err
+			}
+			force = true
+		default:
+			for _, change := range changes {
+				if diff := change.Diff(); diff != "" {
+					printDiff(diff)
+				}
+			}
+		}
 	}
 
 	// 输出文件管理
-	outputPath := adapter.DefaultOutputPath()
-	if err := writeOutputFile(outputPath, outputData); err != nil {
-		return fmt.Errorf("写入输出文件失败: %w", err)
+	if err := writeOutputFiles(adapter.Name(), files, force); err != nil {
+		return nil, fmt.Errorf("写入输出文件失败: %w", err)
 	}
 
-	return nil
+	// 清理之前生成、本次不再输出的文件
+	removed, err := removeFiles(stale)
+	for _, path := range removed {
+		yellowBold(fmt.Sprintf("🗑️  已删除过期文件: %s", path))
+	}
+	if err != nil {
+		return paths, fmt.Errorf("清理过期文件失败: %w", err)
+	}
+
+	return paths, nil
 }
 
-// ensureOutputDirectory 确保输出目录存在
-func ensureOutputDirectory(adapter platform.PlatformAdapter) error {
-	outputPath := adapter.DefaultOutputPath()
-	outputDir := filepath.Dir(outputPath)
-	
-	if err := os.MkdirAll(outputDir, 0755); err != nil {
-		return fmt.Errorf("创建输出目录失败: %w", err)
+// renderPlatform 在内存中将规则转换为指定平台格式，不写入任何文件
+// outputPath 不为空时替换适配器主文件的默认输出路径
+func renderPlatform(adapter platform.PlatformAdapter, ruleSet *rules.RuleSet, outputPath string) (*platform.Output, error) {
+	if aware, ok := adapter.(platform.OutputPathAware); ok {
+		aware.SetOutputPath(outputPath)
 	}
-	
-	return nil
-}
 
-// writeOutputFile 写入输出文件
-func writeOutputFile(outputPath string, data []byte) error {
-	// 检查文件是否已存在
-	if _, err := os.Stat(outputPath); err == nil {
-		if !forceFlag {
-			// 询问用户是否覆盖
-			yellowBold(fmt.Sprintf("⚠️  文件已存在: %s", outputPath))
-			yellowBold("使用 --force 标志强制覆盖，或手动删除后重试")
-			return fmt.Errorf("文件已存在，请使用 --force 标志覆盖")
-		}
-		
-		// 强制覆盖
-		if err := os.WriteFile(outputPath, data, 0644); err != nil {
-			return fmt.Errorf("覆盖文件失败: %w", err)
-		}
-		
-		greenBold(fmt.Sprintf("✅ 已覆盖现有文件: %s", outputPath))
-	} else {
-		// 新文件创建
-		if err := os.WriteFile(outputPath, data, 0644); err != nil {
-			return fmt.Errorf("创建文件失败: %w", err)
+	output, err := adapter.Convert(ruleSet)
+	if err != nil {
+		return nil, fmt.Errorf("规则转换失败: %w", err)
+	}
+	if len(output.Files) == 0 {
+		return nil, fmt.Errorf("规则转换失败: %s 适配器未生成任何文件", adapter.Name())
+	}
+
+	greenBold(fmt.Sprintf("✅ 已完成 %s 规则格式转换", adapter.Name()))
+	for _, warning := range output.Warnings {
+		yellowBold(fmt.Sprintf("⚠️  %s: %s", adapter.Name(), warning))
+	}
+
+	if outputPath != "" {
+		for i := range output.Files {
+			if output.Files[i].Path == adapter.DefaultOutputPath() {
+				output.Files[i].Path = outputPath
+			}
 		}
-		
-		greenBold(fmt.Sprintf("✅ %s 规则已生成: %s", platformFlag, outputPath))
 	}
-	
-	return nil
+
+	return output, nil
 }
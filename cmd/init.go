@@ -12,6 +12,7 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
+	"github/pfinal/pf_ruler/pkg/rules"
 	"gopkg.in/yaml.v3"
 )
 
@@ -198,7 +199,24 @@ func collectProjectRequirements() []string {
 		Options: []string{
 			"Trae",
 			"Cursor",
+			"Claude Code",
 			"GitHub Copilot X",
+			"Windsurf",
+			"Codex",
+			"Cline",
+			"Roo Code",
+			"Gemini CLI",
+			"Aider",
+			"Kiro",
+			"JetBrains AI Assistant",
+			"Junie",
+			"Continue",
+			"Amazon Q Developer",
+			"Augment Code",
+			"Zed",
+			"Warp",
+			"Goose",
+			"OpenHands",
 		},
 	}
 
@@ -293,11 +311,9 @@ func generateConfigFile() {
 	}
 
 	// 定义配置内容
-	configData := map[string]interface{}{
-		"default_platform": "trae",
-		"rule_priority":    [3]string{"project", "global", "templates"},
-		"last_init_time":   time.Now().Format("2006-01-02 15:04:05"),
-	}
+	// 不写入 default_platform，默认为 tech_stack.yaml 中的所有 ai_editors 生成规则
+	configData := rules.DefaultConfig()
+	configData.LastInitTime = time.Now().Format("2006-01-02 15:04:05")
 
 	// 转换为 YAML
 	configYaml, err := yaml.Marshal(configData)
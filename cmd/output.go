@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github/pfinal/pf_ruler/pkg/platform"
+)
+
+// writeOutputFiles 将一个平台的输出文件作为整体写入
+// 先检查所有文件是否可以写入，再写入临时文件，全部成功后才替换目标文件，
+// 替换中途失败时恢复已替换的文件，避免出现部分文件已更新、部分文件未更新的情况；force 为 true 时直接覆盖已有文件
+func writeOutputFiles(platformName string, files []platform.OutputFile, force bool) error {
+	// 1. 检查冲突：存在已有文件且未指定 --force 时整体放弃（已合并现有内容的文件除外）
+	var existing []string
+	var conflicts []string
+	for _, file := range files {
+		if _, err := os.Stat(file.Path); err == nil {
+			existing = append(existing, file.Path)
+			if !file.Merged {
+				conflicts = append(conflicts, file.Path)
+			}
+		}
+	}
+
+	if len(conflicts) > 0 && !force {
+		for _, path := range conflicts {
+			yellowBold(fmt.Sprintf("⚠️  文件已存在: %s", path))
+		}
+		yellowBold("使用 --force 标志强制覆盖，或手动删除后重试")
+		return fmt.Errorf("%d 个文件已存在，请使用 --force 标志覆盖", len(conflicts))
+	}
+
+	// 2. 写入临时文件
+	tempPaths := make([]string, 0, len(files))
+	cleanup := func() {
+		for _, tempPath := range tempPaths {
+			os.Remove(tempPath)
+		}
+	}
+
+	for _, file := range files {
+		// 确保输出目录存在
+		if err := ensureOutputDirectory(file.Path); err != nil {
+			cleanup()
+			return err
+		}
+
+		tempPath := file.Path + ".pf_ruler.tmp"
+		if err := os.WriteFile(tempPath, file.Content, file.FileMode()); err != nil {
+			cleanup()
+			return fmt.Errorf("写入 %s 失败: %w", file.Path, err)
+		}
+		tempPaths = append(tempPaths, tempPath)
+	}
+
+	// 3. 保存将被替换的文件的原内容，替换中途失败时用于恢复
+	backups := make(map[string]fileBackup, len(existing))
+	for _, path := range existing {
+		backup, err := readFileBackup(path)
+		if err != nil {
+			cleanup()
+			return err
+		}
+		backups[path] = backup
+	}
+
+	// 4. 替换目标文件，任一文件替换失败时恢复已替换的文件
+	for i, file := range files {
+		if err := os.Rename(tempPaths[i], file.Path); err != nil {
+			cleanup()
+			if restoreErr := restoreOutputFiles(files[:i], backups); restoreErr != nil {
+				return fmt.Errorf("写入 %s 失败: %w（恢复已写入的文件失败: %v）", file.Path, err, restoreErr)
+			}
+			return fmt.Errorf("写入 %s 失败: %w", file.Path, err)
+		}
+	}
+
+	for _, file := range files {
+		_, overwritten := backups[file.Path]
+		if overwritten && file.Merged {
+			greenBold(fmt.Sprintf("✅ 已更新现有文件: %s", file.Path))
+		} else if overwritten {
+			greenBold(fmt.Sprintf("✅ 已覆盖现有文件: %s", file.Path))
+		} else {
+			greenBold(fmt.Sprintf("✅ %s 规则已生成: %s", platformName, file.Path))
+		}
+	}
+
+	return nil
+}
+
+// fileBackup 被替换前的文件内容和权限
+type fileBackup struct {
+	content []byte
+	mode    os.FileMode
+}
+
+// readFileBackup 读取将被替换的文件的内容和权限
+func readFileBackup(path string) (fileBackup, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileBackup{}, fmt.Errorf("读取 %s 失败: %w", path, err)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fileBackup{}, fmt.Errorf("读取 %s 失败: %w", path, err)
+	}
+	return fileBackup{content: content, mode: info.Mode().Perm()}, nil
+}
+
+// restoreOutputFiles 将已替换的文件恢复为原内容，原本不存在的文件直接删除
+func restoreOutputFiles(files []platform.OutputFile, backups map[string]fileBackup) error {
+	var errs []error
+	for _, file := range files {
+		backup, ok := backups[file.Path]
+		if !ok {
+			if err := os.Remove(file.Path); err != nil && !os.IsNotExist(err) {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if err := os.WriteFile(file.Path, backup.content, backup.mode); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// removeFiles 删除 findStaleFiles 找到的过期文件，返回已删除的文件
+func removeFiles(stale []string) ([]string, error) {
+	var removed []string
+	for _, path := range stale {
+		if err := os.Remove(path); err != nil {
+			return removed, err
+		}
+		removed = append(removed, path)
+	}
+
+	return removed, nil
+}
+
+// findStaleFiles 返回匹配 owned 模式、由 pf_ruler 生成且不在 written 中的文件
+// 不包含 platform.GeneratedMarker 的文件视为用户手写文件，不会被删除
+func findStaleFiles(owned, written []string) ([]string, error) {
+	keep := make(map[string]bool, len(written))
+	for _, path := range written {
+		keep[filepath.Clean(path)] = true
+	}
+
+	var stale []string
+	for _, pattern := range owned {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("无效的文件模式 %s: %w", pattern, err)
+		}
+
+		for _, match := range matches {
+			if keep[filepath.Clean(match)] {
+				continue
+			}
+
+			data, err := os.ReadFile(match)
+			if err != nil || !strings.Contains(string(data), platform.GeneratedMarker) {
+				continue
+			}
+			stale = append(stale, match)
+		}
+	}
+
+	return stale, nil
+}
+
+// ensureOutputDirectory 确保输出文件所在目录存在
+func ensureOutputDirectory(outputPath string) error {
+	outputDir := filepath.Dir(outputPath)
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return fmt.Errorf("创建输出目录失败: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github/pfinal/pf_ruler/pkg/platform"
+)
+
+func TestRestoreOutputFiles(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "existing.md")
+	created := filepath.Join(dir, "created.md")
+
+	if err := os.WriteFile(existing, []byte("新内容\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(created, []byte("新内容\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	files := []platform.OutputFile{{Path: existing}, {Path: created}}
+	backups := map[string]fileBackup{
+		existing: {content: []byte("原内容\n"), mode: 0600},
+	}
+	if err := restoreOutputFiles(files, backups); err != nil {
+		t.Fatalf("意外的错误: %v", err)
+	}
+
+	data, err := os.ReadFile(existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "原内容\n" {
+		t.Errorf("%s 的内容 = %q，期望恢复为原内容", existing, data)
+	}
+	if _, err := os.Stat(created); !os.IsNotExist(err) {
+		t.Errorf("原本不存在的 %s 应被删除", created)
+	}
+}
+
+func TestWriteOutputFilesConflict(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "existing.md")
+	created := filepath.Join(dir, "created.md")
+
+	if err := os.WriteFile(existing, []byte("手写内容\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	files := []platform.OutputFile{
+		{Path: created, Content: []byte("新内容\n")},
+		{Path: existing, Content: []byte("新内容\n")},
+	}
+	if err := writeOutputFiles("test", files, false); err == nil {
+		t.Fatal("期望已有文件冲突时返回错误")
+	}
+
+	// 任一文件冲突时不写入任何文件
+	if _, err := os.Stat(created); !os.IsNotExist(err) {
+		t.Errorf("冲突时不应写入 %s", created)
+	}
+	data, err := os.ReadFile(existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "手写内容\n" {
+		t.Errorf("冲突时不应修改 %s", existing)
+	}
+}
+
+func TestFindStaleFiles(t *testing.T) {
+	dir := t.TempDir()
+	generated := "<!-- " + platform.GeneratedMarker + " -->\n"
+	files := map[string]string{
+		"rules/current.md": generated,
+		"rules/old.md":     generated,
+		"rules/user.md":    "用户手写的规则\n",
+		"rules/other.txt":  generated,
+		"root.md":          generated,
+	}
+	for name, content := range files {
+		filePath := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		owned   []string
+		written []string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "删除不再输出的生成文件，保留手写文件",
+			owned:   []string{filepath.Join(dir, "rules", "*.md")},
+			written: []string{filepath.Join(dir, "rules", "current.md")},
+			want:    []string{filepath.Join(dir, "rules", "old.md")},
+		},
+		{
+			name:    "写入路径未清理时同样视为本次输出",
+			owned:   []string{filepath.Join(dir, "rules", "*.md")},
+			written: []string{filepath.Join(dir, "rules", "..", "rules", "current.md"), filepath.Join(dir, "rules", "old.md")},
+		},
+		{
+			name:  "多个模式",
+			owned: []string{filepath.Join(dir, "root.md"), filepath.Join(dir, "rules", "*.txt")},
+			want:  []string{filepath.Join(dir, "root.md"), filepath.Join(dir, "rules", "other.txt")},
+		},
+		{
+			name:  "没有匹配的文件",
+			owned: []string{filepath.Join(dir, "missing", "*.md")},
+		},
+		{
+			name:    "无效的模式",
+			owned:   []string{filepath.Join(dir, "[")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findStaleFiles(tt.owned, tt.written)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("期望返回错误，实际为 nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("意外的错误: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("findStaleFiles() = %q，期望 %q", got, tt.want)
+			}
+		})
+	}
+}
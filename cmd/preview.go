@@ -0,0 +1,165 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/mattn/go-isatty"
+
+	"github/pfinal/pf_ruler/pkg/platform"
+)
+
+// fileChange 输出文件与磁盘上现有文件的比较结果
+type fileChange struct {
+	File platform.OutputFile
+
+	// 现有文件是否存在及其内容
+	Exists  bool
+	Current []byte
+}
+
+// Changed 判断写入后文件内容是否会改变
+func (c fileChange) Changed() bool {
+	return !c.Exists || !bytes.Equal(c.Current, c.File.Content)
+}
+
+// Diff 返回现有文件到输出内容的统一 diff，内容相同时返回空字符串
+func (c fileChange) Diff() string {
+	oldName := "/dev/null"
+	if c.Exists {
+		oldName = diffPath("a", c.File.Path)
+	}
+	return unifiedDiff(oldName, diffPath("b", c.File.Path), c.Current, c.File.Content)
+}
+
+// compareOutputFiles 读取各输出文件的现有内容，与输出内容进行比较
+func compareOutputFiles(files []platform.OutputFile) ([]fileChange, error) {
+	changes := make([]fileChange, 0, len(files))
+	for _, file := range files {
+		change := fileChange{File: file}
+		current, err := os.ReadFile(file.Path)
+		if err == nil {
+			change.Exists = true
+			change.Current = current
+		} else if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("读取 %s 失败: %w", file.Path, err)
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// removalDiff 返回删除过期文件的统一 diff
+func removalDiff(path string) (string, error) {
+	current, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("读取 %s 失败: %w", path, err)
+	}
+	return unifiedDiff(diffPath("a", path), "/dev/null", current, nil), nil
+}
+
+// previewOutput 打印平台将写入和删除的文件，--diff 时同时打印差异，不写入任何文件
+func previewOutput(platformName string, changes []fileChange, stale []string) error {
+	cyanBold(fmt.Sprintf("📝 %s 将生成以下文件（预览，未写入任何文件）：", platformName))
+	for _, change := range changes {
+		switch {
+		case !change.Exists:
+			green(fmt.Sprintf("  + 新建    %s", change.File.Path))
+		case !change.Changed():
+			fmt.Printf("  = 无变化  %s\n", change.File.Path)
+		case change.File.Merged:
+			yellow(fmt.Sprintf("  ~ 更新    %s", change.File.Path))
+		case forceFlag:
+			yellow(fmt.Sprintf("  ~ 覆盖    %s", change.File.Path))
+		default:
+			yellow(fmt.Sprintf("  ~ 覆盖    %s（已存在，需要 --force）", change.File.Path))
+		}
+	}
+	for _, path := range stale {
+		red(fmt.Sprintf("  - 删除    %s", path))
+	}
+
+	if !diffFlag {
+		return nil
+	}
+	for _, change := range changes {
+		if diff := change.Diff(); diff != "" {
+			printDiff(diff)
+		}
+	}
+	for _, path := range stale {
+		diff, err := removalDiff(path)
+		if err != nil {
+			return err
+		}
+		printDiff(diff)
+	}
+	return nil
+}
+
+// isInteractive 判断标准输入和标准输出是否均为终端，只有在终端中才能逐个确认文件
+func isInteractive() bool {
+	return isatty.IsTerminal(os.Stdin.Fd()) && isatty.IsTerminal(os.Stdout.Fd())
+}
+
+// confirmOutputFiles 逐个显示内容有变化的文件的差异并确认是否写入，返回确认写入的文件
+// 内容没有变化的文件不需要确认，也不会重新写入
+func confirmOutputFiles(changes []fileChange) ([]platform.OutputFile, error) {
+	var confirmed []platform.OutputFile
+	for _, change := range changes {
+		if !change.Changed() {
+			continue
+		}
+
+		printDiff(change.Diff())
+		message := fmt.Sprintf("写入 %s？", change.File.Path)
+		if change.Exists && !change.File.Merged {
+			message = fmt.Sprintf("覆盖 %s？", change.File.Path)
+		}
+		ok, err := confirm(message)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			confirmed = append(confirmed, change.File)
+		}
+	}
+	return confirmed, nil
+}
+
+// confirmRemovals 逐个显示过期文件的内容并确认是否删除，返回确认删除的文件
+func confirmRemovals(stale []string) ([]string, error) {
+	var confirmed []string
+	for _, path := range stale {
+		diff, err := removalDiff(path)
+		if err != nil {
+			return nil, err
+		}
+
+		printDiff(diff)
+		ok, err := confirm(fmt.Sprintf("删除过期文件 %s？", path))
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			confirmed = append(confirmed, path)
+		}
+	}
+	return confirmed, nil
+}
+
+// confirm 通过 survey 询问是否继续，按 Ctrl+C 时返回错误
+func confirm(message string) (bool, error) {
+	ok := true
+	if err := survey.AskOne(&survey.Confirm{Message: message, Default: true}, &ok); err != nil {
+		if errors.Is(err, terminal.InterruptErr) {
+			return false, fmt.Errorf("已取消")
+		}
+		return false, err
+	}
+	return ok, nil
+}
@@ -23,6 +23,8 @@ var (
 	cyanBold   = color.New(color.FgCyan, color.Bold).PrintlnFunc()
 	yellowBold = color.New(color.FgYellow, color.Bold).PrintlnFunc()
 	redBold    = color.New(color.FgRed, color.Bold).PrintlnFunc()
+
+	bold = color.New(color.Bold).PrintlnFunc()
 )
 
 // 显示 pfinalclub logo
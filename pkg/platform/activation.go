@@ -0,0 +1,112 @@
+package platform
+
+import (
+	"strings"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+// Activation 规则的激活方式，对应各编辑器中 always / glob / agent requested / manual 等触发模式
+type Activation string
+
+const (
+	// ActivationAlways 始终加载
+	ActivationAlways Activation = "always"
+
+	// ActivationGlob 编辑匹配 globs 的文件时加载
+	ActivationGlob Activation = "glob"
+
+	// ActivationModelDecision 由 AI 根据规则描述决定是否加载
+	ActivationModelDecision Activation = "model_decision"
+
+	// ActivationManual 仅在用户显式引用时加载
+	ActivationManual Activation = "manual"
+)
+
+// alwaysApplyPriority 优先级不低于该值的无作用范围规则始终加载
+const alwaysApplyPriority = 4
+
+// ruleActivation 根据规则的标签、作用范围和优先级推断激活方式
+// 标签 manual / always 优先生效，其次是 globs，最后按优先级区分始终加载和按需加载
+func ruleActivation(rule rules.Rule) Activation {
+	switch {
+	case hasTag(rule, "manual"):
+		return ActivationManual
+	case hasTag(rule, "always"):
+		return ActivationAlways
+	case len(rule.Globs) > 0:
+		return ActivationGlob
+	case rule.Priority >= alwaysApplyPriority:
+		return ActivationAlways
+	default:
+		return ActivationModelDecision
+	}
+}
+
+// groupActivation 推断一组规则整体的激活方式
+// 全部规则限定作用范围时按 glob 激活并合并 globs；任一规则需要始终加载时整组始终加载
+func groupActivation(ruleList []rules.Rule) (Activation, []string) {
+	var globs []string
+	allGlob, allManual, anyAlways := true, true, false
+
+	for _, rule := range ruleList {
+		activation := ruleActivation(rule)
+		if activation == ActivationGlob {
+			for _, glob := range rule.Globs {
+				globs = appendUniqueString(globs, glob)
+			}
+		} else {
+			allGlob = false
+		}
+		if activation != ActivationManual {
+			allManual = false
+		}
+		if activation == ActivationAlways {
+			anyAlways = true
+		}
+	}
+
+	switch {
+	case len(ruleList) > 0 && allGlob:
+		return ActivationGlob, globs
+	case anyAlways:
+		return ActivationAlways, nil
+	case len(ruleList) > 0 && allManual:
+		return ActivationManual, nil
+	default:
+		return ActivationModelDecision, nil
+	}
+}
+
+// groupDescription 返回规则组的描述，单条规则时使用规则描述
+func groupDescription(group RuleGroup) string {
+	if len(group.Rules) == 1 && group.Rules[0].Description != "" {
+		return group.Rules[0].Description
+	}
+
+	titles := make([]string, 0, len(group.Rules))
+	for _, rule := range group.Rules {
+		titles = append(titles, rule.Title)
+	}
+	return group.Title + ": " + strings.Join(titles, ", ")
+}
+
+// hasTag 判断规则是否包含指定标签（不区分大小写）
+func hasTag(rule rules.Rule, tag string) bool {
+	for _, t := range rule.Tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// appendUniqueString 追加不重复的字符串
+func appendUniqueString(items []string, item string) []string {
+	for _, existing := range items {
+		if existing == item {
+			return items
+		}
+	}
+	return append(items, item)
+}
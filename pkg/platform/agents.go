@@ -0,0 +1,226 @@
+package platform
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+const (
+	// agentsFileName AGENTS.md 文件名
+	agentsFileName = "AGENTS.md"
+
+	// agentsManifestPrefix、agentsManifestSuffix 根目录 AGENTS.md 中记录已生成子目录的注释
+	// 形如 <!-- pf_ruler nested: ["services/api","web"] -->，下次生成时据此清理不再输出的子目录 AGENTS.md
+	agentsManifestPrefix = "<!-- pf_ruler nested: "
+	agentsManifestSuffix = " -->"
+)
+
+// AgentsAdapter AGENTS.md 平台适配器
+// AGENTS.md 是 Codex、opencode、Jules 等编码代理共同使用的开放格式。
+// 全局适用的规则写入项目根目录的 AGENTS.md；globs 全部位于同一子目录下的规则写入 <subdir>/AGENTS.md，
+// 编辑该目录下的文件时由最近的 AGENTS.md 生效
+type AgentsAdapter struct {
+	nested bool
+
+	// 被覆盖后的根目录 AGENTS.md 输出路径，为空时使用默认路径
+	outputPath string
+}
+
+// NewAgentsAdapter 创建新的AGENTS.md适配器
+func NewAgentsAdapter() *AgentsAdapter {
+	return &AgentsAdapter{nested: true}
+}
+
+// Name 返回平台名称
+func (a *AgentsAdapter) Name() string {
+	return "agents"
+}
+
+// DefaultOutputPath 返回根目录 AGENTS.md 路径
+func (a *AgentsAdapter) DefaultOutputPath() string {
+	return agentsFileName
+}
+
+// SetOutputPath 设置根目录 AGENTS.md 的实际输出路径，从中读取上次生成的子目录
+func (a *AgentsAdapter) SetOutputPath(path string) {
+	a.outputPath = path
+}
+
+// Configure 应用平台选项
+//   - nested: 是否将限定在子目录的规则写入 <subdir>/AGENTS.md（默认 true）
+func (a *AgentsAdapter) Configure(options map[string]string) error {
+	if err := checkOptions(a.Name(), options, "nested"); err != nil {
+		return err
+	}
+
+	nested, err := boolOption(options, "nested", a.nested)
+	if err != nil {
+		return err
+	}
+
+	a.nested = nested
+	return nil
+}
+
+// Convert 将统一规则转换为 AGENTS.md 格式，返回根目录及各子目录的 AGENTS.md
+func (a *AgentsAdapter) Convert(ruleSet *rules.RuleSet) (*Output, error) {
+	ruleSet = withDefaultLanguage(ruleSet, rules.LangEN)
+	msg := messagesFor(ruleSet, rules.LangEN)
+
+	// 只拥有根目录和上次生成的子目录中的 AGENTS.md，不会删除其他目录（如第三方依赖）中的文件
+	mainPath := resolveOutputPath(a.outputPath, a.DefaultOutputPath())
+	previous, err := readAgentsManifest(mainPath)
+	if err != nil {
+		return nil, fmt.Errorf("读取 %s 失败: %w", mainPath, err)
+	}
+	owned := []string{agentsFileName}
+	for _, dir := range previous {
+		owned = append(owned, path.Join(dir, agentsFileName))
+	}
+	output := &Output{Owned: owned}
+
+	// 按规则所在目录划分，空字符串表示项目根目录
+	ruleDir := func(rule rules.Rule) string {
+		if !a.nested {
+			return ""
+		}
+		return globsDir(rule.Globs)
+	}
+
+	// 收集子目录并按路径排序，保证输出顺序稳定
+	var dirs []string
+	seen := make(map[string]bool)
+	for _, section := range ruleSet.Sections() {
+		for _, rule := range section.Rules {
+			dir := ruleDir(rule)
+			if rule.Enabled && dir != "" && !seen[dir] {
+				seen[dir] = true
+				dirs = append(dirs, dir)
+			}
+		}
+	}
+	sort.Strings(dirs)
+
+	groups, err := GroupRules(filterRuleSet(ruleSet, func(rule rules.Rule) bool {
+		return ruleDir(rule) == ""
+	}), GroupBySource)
+	if err != nil {
+		return nil, err
+	}
+
+	var content strings.Builder
+	content.WriteString("# " + msg.text("title.agent_guidelines", ruleSet.Metadata.ProjectName) + "\n\n")
+	content.WriteString(generatedNotice(msg, a.Name()))
+	if len(dirs) > 0 {
+		manifest, err := json.Marshal(dirs)
+		if err != nil {
+			return nil, err
+		}
+		content.WriteString(agentsManifestPrefix + string(manifest) + agentsManifestSuffix + "\n")
+	}
+	content.WriteString("\n")
+	writeProjectInfo(&content, msg, ruleSet.Metadata)
+	content.WriteString(msg.text("priority_order") + "\n\n")
+	for _, group := range groups {
+		writeScopedGroupMarkdown(&content, msg, group, 2)
+	}
+	output.Files = append(output.Files, OutputFile{Path: a.DefaultOutputPath(), Content: markdownBytes(&content)})
+
+	for _, dir := range dirs {
+		dir := dir
+		groups, err := GroupRules(filterRuleSet(ruleSet, func(rule rules.Rule) bool {
+			return ruleDir(rule) == dir
+		}), GroupBySource)
+		if err != nil {
+			return nil, err
+		}
+
+		var nested strings.Builder
+		nested.WriteString("# " + msg.text("title.agent_guidelines", dir) + "\n\n")
+		nested.WriteString(generatedNotice(msg, a.Name()))
+		nested.WriteString("\n")
+		nested.WriteString(msg.text("agents.nested_intro", dir) + "\n\n")
+		for _, group := range groups {
+			writeScopedGroupMarkdown(&nested, msg, group, 2)
+		}
+
+		output.Files = append(output.Files, OutputFile{
+			Path:    path.Join(dir, agentsFileName),
+			Content: markdownBytes(&nested),
+		})
+	}
+
+	return output, nil
+}
+
+// readAgentsManifest 读取根目录 AGENTS.md 中记录的上次生成的子目录，文件或记录不存在时返回空列表
+// 记录格式不正确或包含项目外路径的子目录会被忽略
+func readAgentsManifest(filePath string) ([]string, error) {
+	data, _, err := readExistingFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, agentsManifestPrefix) || !strings.HasSuffix(line, agentsManifestSuffix) {
+			continue
+		}
+
+		var recorded []string
+		manifest := strings.TrimSuffix(strings.TrimPrefix(line, agentsManifestPrefix), agentsManifestSuffix)
+		if err := json.Unmarshal([]byte(manifest), &recorded); err != nil {
+			return nil, nil
+		}
+
+		var dirs []string
+		for _, dir := range recorded {
+			if dir != "" && !path.IsAbs(dir) && path.Clean(dir) == dir && dir != ".." && !strings.HasPrefix(dir, "../") {
+				dirs = append(dirs, dir)
+			}
+		}
+		return dirs, nil
+	}
+	return nil, nil
+}
+
+// globsDir 返回所有 globs 共同所在的子目录，不存在共同子目录时返回空字符串
+// 如 services/api/**/*.go 和 services/api/*.yaml 返回 services/api
+func globsDir(globs []string) string {
+	var common []string
+	for i, glob := range globs {
+		if path.IsAbs(glob) {
+			return ""
+		}
+		segments := strings.Split(path.Clean(strings.TrimPrefix(glob, "./")), "/")
+
+		// 最后一段是文件名或通配模式，目录部分到第一个包含通配符的段为止
+		var dir []string
+		for _, segment := range segments[:len(segments)-1] {
+			if strings.ContainsAny(segment, "*?[{") || segment == ".." {
+				break
+			}
+			dir = append(dir, segment)
+		}
+
+		if i == 0 {
+			common = dir
+			continue
+		}
+		n := 0
+		for n < len(common) && n < len(dir) && common[n] == dir[n] {
+			n++
+		}
+		common = common[:n]
+	}
+
+	return strings.Join(common, "/")
+}
@@ -0,0 +1,96 @@
+package platform
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadAgentsManifest(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{
+			name:    "没有记录",
+			content: "# Agent Guidelines\n",
+		},
+		{
+			name:    "记录子目录",
+			content: "# Agent Guidelines\n\n<!-- pf_ruler nested: [\"services/api\",\"web\"] -->\n",
+			want:    []string{"services/api", "web"},
+		},
+		{
+			name:    "忽略项目外路径",
+			content: "<!-- pf_ruler nested: [\"../other\",\"/etc\",\"a/../b\",\"web\"] -->\n",
+			want:    []string{"web"},
+		},
+		{
+			name:    "格式不正确",
+			content: "<!-- pf_ruler nested: services/api -->\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), agentsFileName)
+			if err := os.WriteFile(filePath, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := readAgentsManifest(filePath)
+			if err != nil {
+				t.Fatalf("意外的错误: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("readAgentsManifest() = %q，期望 %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAgentsAdapterConvert(t *testing.T) {
+	runAdapterCases(t, func() PlatformAdapter { return NewAgentsAdapter() }, []adapterCase{
+		{
+			name:  "子目录规则写入嵌套的 AGENTS.md",
+			paths: []string{"AGENTS.md", "services/api/AGENTS.md"},
+			contains: map[string][]string{
+				"AGENTS.md":              {GeneratedMarker, "<!-- pf_ruler nested: [\"services/api\"] -->\n", "Never hardcode secrets.", "Use camelCase."},
+				"services/api/AGENTS.md": {GeneratedMarker, "`services/api/`", "Return JSON errors."},
+			},
+			excludes: map[string][]string{
+				"AGENTS.md":              {"Return JSON errors.", "Never shown."},
+				"services/api/AGENTS.md": {"Never hardcode secrets.", "pf_ruler nested"},
+			},
+		},
+		{
+			name:    "关闭 nested",
+			options: map[string]string{"nested": "false"},
+			paths:   []string{"AGENTS.md"},
+			contains: map[string][]string{
+				"AGENTS.md": {"Never hardcode secrets.", "Return JSON errors."},
+			},
+			excludes: map[string][]string{"AGENTS.md": {"pf_ruler nested"}},
+		},
+	})
+}
+
+func TestAgentsAdapterOwned(t *testing.T) {
+	root := filepath.Join(t.TempDir(), agentsFileName)
+	previous := "<!-- pf_ruler nested: [\"services/api\",\"web/app\"] -->\n"
+	if err := os.WriteFile(root, []byte(previous), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	adapter := NewAgentsAdapter()
+	adapter.SetOutputPath(root)
+	output := convertWith(t, adapter, nil, testRuleSet())
+
+	// 只拥有根目录和上次记录的子目录中的 AGENTS.md，不使用通配符
+	want := []string{"AGENTS.md", "services/api/AGENTS.md", "web/app/AGENTS.md"}
+	if !equalStrings(output.Owned, want) {
+		t.Errorf("Owned = %q，期望 %q", output.Owned, want)
+	}
+}
@@ -0,0 +1,111 @@
+package platform
+
+import (
+	"fmt"
+	"strings"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+// aiderConfigPath Aider 项目级配置文件
+const aiderConfigPath = ".aider.conf.yml"
+
+// AiderAdapter Aider平台适配器
+// 规则写入项目根目录的 CONVENTIONS.md，并在 .aider.conf.yml 的 read 列表中加入该文件，
+// 使 Aider 启动时自动以只读方式加载规则
+type AiderAdapter struct {
+	updateConfig bool
+
+	// 被覆盖后的 CONVENTIONS.md 输出路径，为空时使用默认路径
+	outputPath string
+}
+
+// NewAiderAdapter 创建新的Aider适配器
+func NewAiderAdapter() *AiderAdapter {
+	return &AiderAdapter{updateConfig: true}
+}
+
+// Name 返回平台名称
+func (a *AiderAdapter) Name() string {
+	return "aider"
+}
+
+// DefaultOutputPath 返回CONVENTIONS.md路径
+func (a *AiderAdapter) DefaultOutputPath() string {
+	return "CONVENTIONS.md"
+}
+
+// SetOutputPath 设置 CONVENTIONS.md 的实际输出路径，用于 .aider.conf.yml 的 read 列表
+func (a *AiderAdapter) SetOutputPath(path string) {
+	a.outputPath = path
+}
+
+// Configure 应用平台选项
+//   - update_config: 是否在 .aider.conf.yml 的 read 列表中加入 CONVENTIONS.md（默认 true）
+func (a *AiderAdapter) Configure(options map[string]string) error {
+	if err := checkOptions(a.Name(), options, "update_config"); err != nil {
+		return err
+	}
+
+	updateConfig, err := boolOption(options, "update_config", a.updateConfig)
+	if err != nil {
+		return err
+	}
+
+	a.updateConfig = updateConfig
+	return nil
+}
+
+// Convert 将统一规则转换为Aider格式，返回 CONVENTIONS.md 及合并后的 .aider.conf.yml
+func (a *AiderAdapter) Convert(ruleSet *rules.RuleSet) (*Output, error) {
+	ruleSet = withDefaultLanguage(ruleSet, rules.LangEN)
+	msg := messagesFor(ruleSet, rules.LangEN)
+
+	groups, err := GroupRules(ruleSet, GroupBySource)
+	if err != nil {
+		return nil, err
+	}
+
+	var content strings.Builder
+	content.WriteString("# " + msg.text("title.coding_conventions", ruleSet.Metadata.ProjectName) + "\n\n")
+	content.WriteString(generatedNotice(msg, a.Name()))
+	content.WriteString("\n")
+	writeProjectInfo(&content, msg, ruleSet.Metadata)
+	content.WriteString(msg.text("priority_order") + "\n\n")
+	for _, group := range groups {
+		writeGroupMarkdown(&content, group, 2)
+	}
+
+	output := singleFileOutput(a.DefaultOutputPath(), markdownBytes(&content))
+
+	if a.updateConfig {
+		config, err := a.mergeConfig(resolveOutputPath(a.outputPath, a.DefaultOutputPath()))
+		if err != nil {
+			return nil, err
+		}
+		if config != nil {
+			output.Files = append(output.Files, *config)
+		}
+	}
+
+	return output, nil
+}
+
+// mergeConfig 在 .aider.conf.yml 的 read 列表中加入 CONVENTIONS.md 的实际输出路径
+// 保留其他配置项、顺序和注释；read 已包含该路径时返回 nil，不修改文件
+func (a *AiderAdapter) mergeConfig(fileName string) (*OutputFile, error) {
+	data, mode, err := readExistingFile(aiderConfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("读取 %s 失败: %w", aiderConfigPath, err)
+	}
+
+	content, changed, err := mergeYAMLListItem(data, "read", fileName)
+	if err != nil {
+		return nil, fmt.Errorf("合并 %s 的 read 配置失败: %w", aiderConfigPath, err)
+	}
+	if !changed {
+		return nil, nil
+	}
+
+	return &OutputFile{Path: aiderConfigPath, Content: content, Mode: mode, Merged: true}, nil
+}
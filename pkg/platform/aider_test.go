@@ -0,0 +1,32 @@
+package platform
+
+import "testing"
+
+func TestAiderAdapterConvert(t *testing.T) {
+	runAdapterCases(t, func() PlatformAdapter { return NewAiderAdapter() }, []adapterCase{
+		{
+			name:  "生成 CONVENTIONS.md 并加入 read 列表",
+			paths: []string{"CONVENTIONS.md", ".aider.conf.yml"},
+			contains: map[string][]string{
+				"CONVENTIONS.md":  {GeneratedMarker, "Never hardcode secrets.", "Use camelCase."},
+				".aider.conf.yml": {"read:\n  - CONVENTIONS.md\n"},
+			},
+			excludes: map[string][]string{"CONVENTIONS.md": {"Never shown."}},
+		},
+		{
+			name:    "关闭 update_config",
+			options: map[string]string{"update_config": "false"},
+			paths:   []string{"CONVENTIONS.md"},
+		},
+	})
+}
+
+func TestAiderAdapterOutputPath(t *testing.T) {
+	adapter := NewAiderAdapter()
+	adapter.SetOutputPath("docs/CONVENTIONS.md")
+	output := convertWith(t, adapter, nil, testRuleSet())
+
+	if content := fileContent(t, output, ".aider.conf.yml"); content != "read:\n  - docs/CONVENTIONS.md\n" {
+		t.Errorf(".aider.conf.yml 未使用实际输出路径：\n%s", content)
+	}
+}
@@ -0,0 +1,75 @@
+package platform
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+// amazonQRulesDir Amazon Q Developer 项目规则目录
+const amazonQRulesDir = ".amazonq/rules"
+
+// AmazonQAdapter Amazon Q Developer平台适配器
+// 在 .amazonq/rules/ 下为每个规则分组生成 Markdown 文件，Amazon Q 会加载目录中的全部规则；
+// 规则文件不支持 front matter，带作用范围的规则在正文中注明适用的文件
+type AmazonQAdapter struct {
+	groupBy string
+}
+
+// NewAmazonQAdapter 创建新的Amazon Q Developer适配器
+func NewAmazonQAdapter() *AmazonQAdapter {
+	return &AmazonQAdapter{groupBy: GroupByRule}
+}
+
+// Name 返回平台名称
+func (a *AmazonQAdapter) Name() string {
+	return "amazonq"
+}
+
+// DefaultOutputPath 返回项目信息规则文件路径
+func (a *AmazonQAdapter) DefaultOutputPath() string {
+	return path.Join(amazonQRulesDir, projectFileName+".md")
+}
+
+// Configure 应用平台选项
+//   - group_by: 分组方式 rule、type 或 source（默认 rule）
+func (a *AmazonQAdapter) Configure(options map[string]string) error {
+	if err := checkOptions(a.Name(), options, "group_by"); err != nil {
+		return err
+	}
+
+	groupBy, err := enumOption(options, "group_by", a.groupBy, GroupByRule, GroupByType, GroupBySource)
+	if err != nil {
+		return err
+	}
+
+	a.groupBy = groupBy
+	return nil
+}
+
+// Convert 将统一规则转换为Amazon Q Developer格式，返回项目信息文件和各分组的规则文件
+func (a *AmazonQAdapter) Convert(ruleSet *rules.RuleSet) (*Output, error) {
+	ruleSet = withDefaultLanguage(ruleSet, rules.LangEN)
+	msg := messagesFor(ruleSet, rules.LangEN)
+
+	groups, err := GroupRules(ruleSet, a.groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	var project strings.Builder
+	project.WriteString(fmt.Sprintf("# %s\n\n", ruleSet.Metadata.ProjectName))
+	project.WriteString(generatedNotice(msg, a.Name()))
+	project.WriteString("\n")
+	writeProjectInfo(&project, msg, ruleSet.Metadata)
+
+	directory := ruleDirectory{Dir: amazonQRulesDir, Platform: a.Name(), Messages: msg, AnnotateScope: true}
+	output := &Output{
+		Files: []OutputFile{{Path: a.DefaultOutputPath(), Content: markdownBytes(&project)}},
+		Owned: []string{directory.Owned()},
+	}
+	output.Files = append(output.Files, directory.Files(groups)...)
+	return output, nil
+}
@@ -0,0 +1,47 @@
+package platform
+
+import "testing"
+
+func TestAmazonQAdapterConvert(t *testing.T) {
+	runAdapterCases(t, func() PlatformAdapter { return NewAmazonQAdapter() }, []adapterCase{
+		{
+			name: "按规则生成，不写入 front matter",
+			paths: []string{
+				".amazonq/rules/00-project.md",
+				".amazonq/rules/security.md",
+				".amazonq/rules/api-handlers.md",
+				".amazonq/rules/naming.md",
+			},
+			contains: map[string][]string{
+				".amazonq/rules/00-project.md":   {"# demo", GeneratedMarker},
+				".amazonq/rules/api-handlers.md": {"services/api/**/*.go", "Return JSON errors."},
+			},
+			excludes: map[string][]string{".amazonq/rules/security.md": {"---\n"}},
+		},
+		{
+			name:    "按来源分组",
+			options: map[string]string{"group_by": "source"},
+			paths:   []string{".amazonq/rules/00-project.md", ".amazonq/rules/project.md", ".amazonq/rules/global.md"},
+		},
+	})
+}
+
+func TestAugmentAdapterConvert(t *testing.T) {
+	runAdapterCases(t, func() PlatformAdapter { return NewAugmentAdapter() }, []adapterCase{
+		{
+			name: "按规则生成 type front matter",
+			paths: []string{
+				".augment/rules/00-project.md",
+				".augment/rules/security.md",
+				".augment/rules/api-handlers.md",
+				".augment/rules/naming.md",
+			},
+			contains: map[string][]string{
+				".augment/rules/00-project.md":   {"---\ntype: always\n---\n"},
+				".augment/rules/security.md":     {"---\ntype: always\n---\n", GeneratedMarker, "Never hardcode secrets."},
+				".augment/rules/api-handlers.md": {"---\ntype: auto\ndescription: \"HTTP handler conventions (applies to services/api/**/*.go)\"\n---\n"},
+				".augment/rules/naming.md":       {"---\ntype: auto\ndescription: \"Naming conventions\"\n---\n"},
+			},
+		},
+	})
+}
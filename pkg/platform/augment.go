@@ -0,0 +1,111 @@
+package platform
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+// augmentRulesDir Augment Code 规则目录
+const augmentRulesDir = ".augment/rules"
+
+// augmentTypes 激活方式对应的 Augment 规则类型
+// Augment 不支持按文件匹配加载，限定作用范围的规则由 AI 根据描述自动加载
+var augmentTypes = map[Activation]string{
+	ActivationAlways:        "always",
+	ActivationGlob:          "auto",
+	ActivationModelDecision: "auto",
+	ActivationManual:        "manual",
+}
+
+// AugmentAdapter Augment Code平台适配器
+// 在 .augment/rules/ 下为每个规则分组生成带 type front matter 的 Markdown 文件
+type AugmentAdapter struct {
+	groupBy string
+}
+
+// NewAugmentAdapter 创建新的Augment Code适配器
+func NewAugmentAdapter() *AugmentAdapter {
+	return &AugmentAdapter{groupBy: GroupByRule}
+}
+
+// Name 返回平台名称
+func (a *AugmentAdapter) Name() string {
+	return "augment"
+}
+
+// DefaultOutputPath 返回项目信息规则文件路径
+func (a *AugmentAdapter) DefaultOutputPath() string {
+	return path.Join(augmentRulesDir, projectFileName+".md")
+}
+
+// Configure 应用平台选项
+//   - group_by: 分组方式 rule、type 或 source（默认 rule）
+func (a *AugmentAdapter) Configure(options map[string]string) error {
+	if err := checkOptions(a.Name(), options, "group_by"); err != nil {
+		return err
+	}
+
+	groupBy, err := enumOption(options, "group_by", a.groupBy, GroupByRule, GroupByType, GroupBySource)
+	if err != nil {
+		return err
+	}
+
+	a.groupBy = groupBy
+	return nil
+}
+
+// Convert 将统一规则转换为Augment Code格式，返回项目信息文件和各分组的规则文件
+func (a *AugmentAdapter) Convert(ruleSet *rules.RuleSet) (*Output, error) {
+	ruleSet = withDefaultLanguage(ruleSet, rules.LangEN)
+	msg := messagesFor(ruleSet, rules.LangEN)
+
+	groups, err := GroupRules(ruleSet, a.groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	var project strings.Builder
+	project.WriteString(augmentFrontMatter(ActivationAlways, ""))
+	project.WriteString(generatedNotice(msg, a.Name()))
+	project.WriteString("\n")
+	project.WriteString(fmt.Sprintf("# %s\n\n", ruleSet.Metadata.ProjectName))
+	writeProjectInfo(&project, msg, ruleSet.Metadata)
+
+	directory := ruleDirectory{
+		Dir:           augmentRulesDir,
+		Platform:      a.Name(),
+		Messages:      msg,
+		AnnotateScope: true,
+		FrontMatter: func(group RuleGroup, activation Activation, globs []string) string {
+			description := groupDescription(group)
+			if len(globs) > 0 {
+				description = msg.text("applies_to_plain", description, strings.Join(globs, ", "))
+			}
+			return augmentFrontMatter(activation, description)
+		},
+	}
+	output := &Output{
+		Files: []OutputFile{{Path: a.DefaultOutputPath(), Content: markdownBytes(&project)}},
+		Owned: []string{directory.Owned()},
+	}
+	output.Files = append(output.Files, directory.Files(groups)...)
+	return output, nil
+}
+
+// augmentFrontMatter 返回规则文件的 front matter，auto 规则需要 description 供 AI 判断是否加载
+func augmentFrontMatter(activation Activation, description string) string {
+	ruleType := augmentTypes[activation]
+
+	var content strings.Builder
+	content.WriteString("---\n")
+	content.WriteString(fmt.Sprintf("type: %s\n", ruleType))
+	if ruleType == "auto" {
+		content.WriteString(fmt.Sprintf("description: %s\n", strconv.Quote(strings.Join(strings.Fields(description), " "))))
+	}
+	content.WriteString("---\n\n")
+	return content.String()
+}
@@ -1,6 +1,11 @@
 package platform
 
 import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
 	"github/pfinal/pf_ruler/pkg/rules"
 )
 
@@ -9,15 +14,84 @@ import (
 type PlatformAdapter interface {
 	// Name 返回平台名称（如 "trae"、"cursor"）
 	Name() string
-	
-	// DefaultOutputPath 返回平台规则默认输出路径
-	// 如 ".trae/rules/project_rules.md"、".cursor/rules.json"
+
+	// DefaultOutputPath 返回平台主规则文件的默认输出路径
+	// 如 ".trae/rules/project_rules.md"、"CLAUDE.md"
 	DefaultOutputPath() string
-	
-	// Convert 将统一规则转换为平台格式
-	Convert(ruleSet *rules.RuleSet) ([]byte, error)
+
+	// Convert 将统一规则转换为平台格式，返回需要写入的全部文件
+	// 单文件平台返回一个路径为 DefaultOutputPath 的文件，目录型平台可以返回多个文件
+	Convert(ruleSet *rules.RuleSet) (*Output, error)
+}
+
+// DefaultFileMode 输出文件的默认权限
+const DefaultFileMode os.FileMode = 0644
+
+// OutputFile 适配器输出的单个文件
+type OutputFile struct {
+	// 相对项目根目录的输出路径
+	Path string
+
+	// 文件内容
+	Content []byte
+
+	// 文件权限，为 0 时使用 DefaultFileMode
+	Mode os.FileMode
+
+	// 内容已与现有的用户文件合并（如编辑器配置文件），覆盖时不需要 --force
+	Merged bool
+}
+
+// FileMode 返回文件权限，未指定时返回 DefaultFileMode
+func (f OutputFile) FileMode() os.FileMode {
+	if f.Mode == 0 {
+		return DefaultFileMode
+	}
+	return f.Mode
+}
+
+// Output 适配器的完整输出
+type Output struct {
+	// 输出文件，第一个文件为主文件（即 DefaultOutputPath 对应的文件）
+	Files []OutputFile
+
+	// 转换过程中的警告（如超出平台的大小限制），不影响文件输出
+	Warnings []string
+
+	// 适配器拥有的文件（glob 模式）。匹配这些模式、包含 GeneratedMarker 且不在 Files 中的
+	// 已有文件视为之前生成的过期文件，会在写入后被删除
+	Owned []string
 }
 
+// singleFileOutput 返回只包含一个文件的输出
+func singleFileOutput(path string, content []byte) *Output {
+	return &Output{Files: []OutputFile{{Path: path, Content: content}}}
+}
+
+// Configurable 支持平台级选项的适配器（可选接口）
+// 选项来自 config.yaml 的 platforms.<name>.options，遇到未知选项应返回错误
+type Configurable interface {
+	Configure(options map[string]string) error
+}
+
+// OutputPathAware 需要引用主文件实际输出路径的适配器（可选接口）
+// 如在编辑器配置中写入主文件路径；主文件路径可能被 --output 或 platforms.<name>.output 覆盖，
+// 在 Convert 之前调用，未覆盖时 path 为空字符串
+type OutputPathAware interface {
+	SetOutputPath(path string)
+}
+
+// resolveOutputPath 返回主文件的实际输出路径（使用 / 分隔），未覆盖时返回默认路径
+func resolveOutputPath(outputPath, defaultPath string) string {
+	if outputPath == "" {
+		return defaultPath
+	}
+	return filepath.ToSlash(filepath.Clean(outputPath))
+}
+
+// AllPlatforms --platform 中表示全部已注册平台的保留名称，不能用作平台名称
+const AllPlatforms = "all"
+
 // PlatformRegistry 平台注册表
 type PlatformRegistry struct {
 	adapters map[string]PlatformAdapter
@@ -41,11 +115,59 @@ func (r *PlatformRegistry) Get(name string) (PlatformAdapter, bool) {
 	return adapter, exists
 }
 
-// ListSupported 列出所有支持的平台
+// ListSupported 列出所有支持的平台（按名称排序）
 func (r *PlatformRegistry) ListSupported() []string {
 	platforms := make([]string, 0, len(r.adapters))
 	for name := range r.adapters {
 		platforms = append(platforms, name)
 	}
+	sort.Strings(platforms)
 	return platforms
 }
+
+// editorAliases AI 编辑器显示名称到平台名称的映射
+// 键为小写形式，对应 init 交互中可选的编辑器名称
+var editorAliases = map[string]string{
+	"trae":                   "trae",
+	"cursor":                 "cursor",
+	"claude":                 "claude",
+	"claude code":            "claude",
+	"copilot":                "copilot",
+	"github copilot":         "copilot",
+	"github copilot x":       "copilot",
+	"windsurf":               "windsurf",
+	"agents":                 "agents",
+	"codex":                  "agents",
+	"opencode":               "agents",
+	"jules":                  "agents",
+	"cline":                  "cline",
+	"roo":                    "roo",
+	"roo code":               "roo",
+	"gemini":                 "gemini",
+	"gemini cli":             "gemini",
+	"aider":                  "aider",
+	"kiro":                   "kiro",
+	"jetbrains":              "jetbrains",
+	"jetbrains ai assistant": "jetbrains",
+	"junie":                  "junie",
+	"continue":               "continue",
+	"amazonq":                "amazonq",
+	"amazon q":               "amazonq",
+	"amazon q developer":     "amazonq",
+	"augment":                "augment",
+	"augment code":           "augment",
+	"zed":                    "zed",
+	"warp":                   "warp",
+	"goose":                  "goose",
+	"openhands":              "openhands",
+}
+
+// PlatformForEditor 将 AI 编辑器名称（如 tech_stack.yaml 中的 "GitHub Copilot X"）
+// 转换为平台名称；未知名称按小写原样返回
+func PlatformForEditor(editor string) string {
+	key := strings.ToLower(strings.TrimSpace(editor))
+	if name, ok := editorAliases[key]; ok {
+		return name
+	}
+	return key
+}
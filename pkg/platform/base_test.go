@@ -0,0 +1,151 @@
+package platform
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+// testRuleSet 返回适配器测试使用的规则集
+// 项目规则包含一条高优先级规则和一条限定在 services/api 下的规则，全局规则包含一条低优先级规则和一条已禁用的规则
+func testRuleSet() *rules.RuleSet {
+	return &rules.RuleSet{
+		ProjectRules: []rules.Rule{
+			{Title: "Security", Type: "security", Content: "Never hardcode secrets.", Priority: 5, Enabled: true},
+			{
+				Title: "API Handlers", Type: "code_style", Description: "HTTP handler conventions",
+				Content: "Return JSON errors.", Priority: 3, Enabled: true, Globs: []string{"services/api/**/*.go"},
+			},
+		},
+		GlobalRules: []rules.Rule{
+			{Title: "Naming", Type: "naming", Description: "Naming conventions", Content: "Use camelCase.", Priority: 2, Enabled: true},
+			{Title: "Disabled", Type: "naming", Content: "Never shown.", Priority: 5, Enabled: false},
+		},
+		Metadata: rules.Metadata{ProjectName: "demo"},
+	}
+}
+
+// adapterCase 适配器转换测试用例：使用 options 配置适配器后转换规则集，
+// 检查输出文件的路径及各文件包含（或不包含）的内容
+type adapterCase struct {
+	name    string
+	options map[string]string
+
+	// 转换的规则集，为 nil 时使用 testRuleSet
+	ruleSet *rules.RuleSet
+
+	// 期望的输出文件路径，按输出顺序排列
+	paths []string
+
+	// 文件路径到应包含的内容
+	contains map[string][]string
+
+	// 文件路径到不应包含的内容
+	excludes map[string][]string
+
+	// 期望的警告数量
+	warnings int
+}
+
+// runAdapterCases 对每个用例创建新的适配器并检查转换结果
+func runAdapterCases(t *testing.T, newAdapter func() PlatformAdapter, tests []adapterCase) {
+	t.Helper()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ruleSet := tt.ruleSet
+			if ruleSet == nil {
+				ruleSet = testRuleSet()
+			}
+			output := convertWith(t, newAdapter(), tt.options, ruleSet)
+
+			if got := filePaths(output); !reflect.DeepEqual(got, tt.paths) {
+				t.Fatalf("输出文件 = %q，期望 %q", got, tt.paths)
+			}
+			for filePath, substrings := range tt.contains {
+				content := fileContent(t, output, filePath)
+				for _, substring := range substrings {
+					if !strings.Contains(content, substring) {
+						t.Errorf("%s 应包含 %q，实际内容：\n%s", filePath, substring, content)
+					}
+				}
+			}
+			for filePath, substrings := range tt.excludes {
+				content := fileContent(t, output, filePath)
+				for _, substring := range substrings {
+					if strings.Contains(content, substring) {
+						t.Errorf("%s 不应包含 %q，实际内容：\n%s", filePath, substring, content)
+					}
+				}
+			}
+			if len(output.Warnings) != tt.warnings {
+				t.Errorf("警告 = %q，期望 %d 条", output.Warnings, tt.warnings)
+			}
+		})
+	}
+}
+
+// convertWith 使用 options 配置适配器并转换规则集
+func convertWith(t *testing.T, adapter PlatformAdapter, options map[string]string, ruleSet *rules.RuleSet) *Output {
+	t.Helper()
+	if options != nil {
+		configurable, ok := adapter.(Configurable)
+		if !ok {
+			t.Fatalf("%s 不支持 options 配置", adapter.Name())
+		}
+		if err := configurable.Configure(options); err != nil {
+			t.Fatalf("配置 %s 失败: %v", adapter.Name(), err)
+		}
+	}
+
+	output, err := adapter.Convert(ruleSet)
+	if err != nil {
+		t.Fatalf("转换失败: %v", err)
+	}
+	return output
+}
+
+// filePaths 返回输出文件的路径
+func filePaths(output *Output) []string {
+	paths := make([]string, 0, len(output.Files))
+	for _, file := range output.Files {
+		paths = append(paths, file.Path)
+	}
+	return paths
+}
+
+// fileContent 返回指定路径的输出文件内容，文件不存在时测试失败
+func fileContent(t *testing.T, output *Output, filePath string) string {
+	t.Helper()
+	for _, file := range output.Files {
+		if file.Path == filePath {
+			return string(file.Content)
+		}
+	}
+	t.Fatalf("输出中没有 %s", filePath)
+	return ""
+}
+
+// equalStrings 判断两个字符串列表是否相同，nil 与空列表视为相同
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// containsAll 判断 content 是否包含全部 substrings
+func containsAll(content string, substrings ...string) bool {
+	for _, substring := range substrings {
+		if !strings.Contains(content, substring) {
+			return false
+		}
+	}
+	return true
+}
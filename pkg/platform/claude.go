@@ -0,0 +1,123 @@
+package platform
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+// ClaudeAdapter Claude Code平台适配器
+// 默认将全部规则写入项目根目录的 CLAUDE.md；
+// 开启 split 选项后每个规则分组写入 .claude/rules/ 下的独立文件，并在 CLAUDE.md 中通过 @path 导入
+type ClaudeAdapter struct {
+	split    bool
+	groupBy  string
+	rulesDir string
+}
+
+// NewClaudeAdapter 创建新的Claude Code适配器
+func NewClaudeAdapter() *ClaudeAdapter {
+	return &ClaudeAdapter{
+		groupBy:  GroupBySource,
+		rulesDir: ".claude/rules",
+	}
+}
+
+// Name 返回平台名称
+func (c *ClaudeAdapter) Name() string {
+	return "claude"
+}
+
+// DefaultOutputPath 返回Claude Code规则默认输出路径
+func (c *ClaudeAdapter) DefaultOutputPath() string {
+	return "CLAUDE.md"
+}
+
+// Configure 应用平台选项
+//   - split: 是否按分组拆分为 .claude/rules/*.md 并通过 @path 导入（默认 false）
+//   - group_by: 拆分时的分组方式 source、type 或 rule（默认 source）
+//   - rules_dir: 拆分文件所在目录（默认 .claude/rules）
+func (c *ClaudeAdapter) Configure(options map[string]string) error {
+	if err := checkOptions(c.Name(), options, "split", "group_by", "rules_dir"); err != nil {
+		return err
+	}
+
+	split, err := boolOption(options, "split", c.split)
+	if err != nil {
+		return err
+	}
+
+	groupBy, err := enumOption(options, "group_by", c.groupBy, GroupBySource, GroupByType, GroupByRule)
+	if err != nil {
+		return err
+	}
+
+	c.split = split
+	c.groupBy = groupBy
+	if dir := strings.TrimSpace(options["rules_dir"]); dir != "" {
+		c.rulesDir = strings.TrimSuffix(dir, "/")
+	}
+
+	return nil
+}
+
+// Convert 将统一规则转换为Claude Code格式，返回 CLAUDE.md 及拆分出的规则文件
+func (c *ClaudeAdapter) Convert(ruleSet *rules.RuleSet) (*Output, error) {
+	ruleSet = withDefaultLanguage(ruleSet, rules.LangEN)
+	msg := messagesFor(ruleSet, rules.LangEN)
+
+	var content strings.Builder
+
+	content.WriteString("# " + msg.text("title.project_rules", ruleSet.Metadata.ProjectName) + "\n\n")
+	content.WriteString(generatedNotice(msg, c.Name()))
+	content.WriteString("\n")
+	writeProjectInfo(&content, msg, ruleSet.Metadata)
+
+	// 两种模式都拥有规则目录中的文件，关闭 split 或重命名分组后清理之前拆分出的规则文件
+	owned := []string{path.Join(c.rulesDir, "*.md")}
+
+	if !c.split {
+		groups, err := GroupRules(ruleSet, GroupBySource)
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range groups {
+			writeGroupMarkdown(&content, group, 2)
+		}
+
+		return &Output{
+			Files: []OutputFile{{Path: c.DefaultOutputPath(), Content: markdownBytes(&content)}},
+			Owned: owned,
+		}, nil
+	}
+
+	groups, err := GroupRules(ruleSet, c.groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &Output{Files: []OutputFile{{Path: c.DefaultOutputPath()}}, Owned: owned}
+
+	// CLAUDE.md 只保留导入列表，具体规则写入独立文件
+	content.WriteString("## " + msg.text("heading.rules") + "\n\n")
+	content.WriteString(msg.text("priority_order") + "\n\n")
+	for _, group := range groups {
+		rulePath := path.Join(c.rulesDir, group.Name+".md")
+		content.WriteString(fmt.Sprintf("- %s: @%s\n", group.Title, rulePath))
+
+		var groupContent strings.Builder
+		groupContent.WriteString(generatedNotice(msg, c.Name()))
+		groupContent.WriteString("\n")
+		writeGroupMarkdown(&groupContent, group, 1)
+
+		output.Files = append(output.Files, OutputFile{
+			Path:    rulePath,
+			Content: markdownBytes(&groupContent),
+		})
+	}
+
+	output.Files[0].Content = markdownBytes(&content)
+	return output, nil
+}
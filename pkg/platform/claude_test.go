@@ -0,0 +1,44 @@
+package platform
+
+import "testing"
+
+func TestClaudeAdapterConvert(t *testing.T) {
+	runAdapterCases(t, func() PlatformAdapter { return NewClaudeAdapter() }, []adapterCase{
+		{
+			name:  "单文件",
+			paths: []string{"CLAUDE.md"},
+			contains: map[string][]string{
+				"CLAUDE.md": {GeneratedMarker, "## Project-Specific Rules", "### Security", "Never hardcode secrets.", "Use camelCase."},
+			},
+			excludes: map[string][]string{"CLAUDE.md": {"Never shown.", "@.claude/rules/"}},
+		},
+		{
+			name:    "按来源拆分",
+			options: map[string]string{"split": "true"},
+			paths:   []string{"CLAUDE.md", ".claude/rules/project.md", ".claude/rules/global.md"},
+			contains: map[string][]string{
+				"CLAUDE.md":                {"@.claude/rules/project.md", "@.claude/rules/global.md"},
+				".claude/rules/project.md": {GeneratedMarker, "Never hardcode secrets.", "Return JSON errors."},
+				".claude/rules/global.md":  {"Use camelCase."},
+			},
+			excludes: map[string][]string{"CLAUDE.md": {"Never hardcode secrets."}},
+		},
+		{
+			name:    "按规则拆分到自定义目录",
+			options: map[string]string{"split": "true", "group_by": "rule", "rules_dir": "docs/claude/"},
+			paths:   []string{"CLAUDE.md", "docs/claude/security.md", "docs/claude/api-handlers.md", "docs/claude/naming.md"},
+			contains: map[string][]string{
+				"CLAUDE.md": {"@docs/claude/security.md", "@docs/claude/api-handlers.md", "@docs/claude/naming.md"},
+			},
+		},
+	})
+}
+
+func TestClaudeAdapterOwned(t *testing.T) {
+	for _, options := range []map[string]string{nil, {"split": "true"}} {
+		output := convertWith(t, NewClaudeAdapter(), options, testRuleSet())
+		if len(output.Owned) != 1 || output.Owned[0] != ".claude/rules/*.md" {
+			t.Errorf("options %v 的 Owned = %q，期望 [.claude/rules/*.md]", options, output.Owned)
+		}
+	}
+}
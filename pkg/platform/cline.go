@@ -0,0 +1,80 @@
+package platform
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+// clineRulesDir Cline 规则目录
+const clineRulesDir = ".clinerules"
+
+// ClineAdapter Cline平台适配器
+// 在 .clinerules/ 目录下生成带编号的 Markdown 文件，Cline 会按文件名顺序加载全部规则；
+// 编号由规则优先级决定，优先级越高越靠前
+type ClineAdapter struct {
+	groupBy string
+}
+
+// NewClineAdapter 创建新的Cline适配器
+func NewClineAdapter() *ClineAdapter {
+	return &ClineAdapter{groupBy: GroupByRule}
+}
+
+// Name 返回平台名称
+func (c *ClineAdapter) Name() string {
+	return "cline"
+}
+
+// DefaultOutputPath 返回项目信息规则文件路径
+func (c *ClineAdapter) DefaultOutputPath() string {
+	return path.Join(clineRulesDir, projectFileName+".md")
+}
+
+// Configure 应用平台选项
+//   - group_by: 分组方式 rule、type 或 source（默认 rule）
+func (c *ClineAdapter) Configure(options map[string]string) error {
+	if err := checkOptions(c.Name(), options, "group_by"); err != nil {
+		return err
+	}
+
+	groupBy, err := enumOption(options, "group_by", c.groupBy, GroupByRule, GroupByType, GroupBySource)
+	if err != nil {
+		return err
+	}
+
+	c.groupBy = groupBy
+	return nil
+}
+
+// Convert 将统一规则转换为Cline格式，返回项目信息文件和按优先级编号的规则文件
+func (c *ClineAdapter) Convert(ruleSet *rules.RuleSet) (*Output, error) {
+	ruleSet = withDefaultLanguage(ruleSet, rules.LangEN)
+	msg := messagesFor(ruleSet, rules.LangEN)
+
+	groups, err := GroupRules(ruleSet, c.groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	var project strings.Builder
+	project.WriteString(fmt.Sprintf("# %s\n\n", ruleSet.Metadata.ProjectName))
+	project.WriteString(generatedNotice(msg, c.Name()))
+	project.WriteString("\n")
+	writeProjectInfo(&project, msg, ruleSet.Metadata)
+	project.WriteString(msg.text("numbered_order") + "\n")
+
+	output := &Output{
+		Files: []OutputFile{{Path: c.DefaultOutputPath(), Content: markdownBytes(&project)}},
+		Owned: []string{path.Join(clineRulesDir, "*.md")},
+	}
+	output.Files = append(output.Files, numberedRuleFiles(clineRulesDir, c.Name(), msg, groups)...)
+	return output, nil
+}
+
+// numberedRuleFiles 为每个分组生成一个以优先级编号命名的 Markdown 规则文件
+func numberedRuleFiles(dir, platformName string, msg *messages, groups []RuleGroup) []OutputFile {
+	return ruleDirectory{Dir: dir, Platform: platformName, Messages: msg, FileName: priorityFileName}.Files(groups)
+}
@@ -0,0 +1,27 @@
+package platform
+
+import "testing"
+
+func TestClineAdapterConvert(t *testing.T) {
+	runAdapterCases(t, func() PlatformAdapter { return NewClineAdapter() }, []adapterCase{
+		{
+			name: "按优先级编号",
+			paths: []string{
+				".clinerules/00-project.md",
+				".clinerules/10-security.md",
+				".clinerules/30-api-handlers.md",
+				".clinerules/40-naming.md",
+			},
+			contains: map[string][]string{
+				".clinerules/00-project.md":  {"# demo", GeneratedMarker},
+				".clinerules/10-security.md": {GeneratedMarker, "Never hardcode secrets."},
+				".clinerules/40-naming.md":   {"Use camelCase."},
+			},
+		},
+		{
+			name:    "按来源分组时取组内最高优先级",
+			options: map[string]string{"group_by": "source"},
+			paths:   []string{".clinerules/00-project.md", ".clinerules/10-project.md", ".clinerules/40-global.md"},
+		},
+	})
+}
@@ -0,0 +1,152 @@
+package platform
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+// continueRulesDir Continue 规则目录
+const continueRulesDir = ".continue/rules"
+
+// ContinueAdapter Continue平台适配器
+// 在 .continue/rules/ 下为每条规则（或每个分组）生成带 name、globs、alwaysApply、description
+// front matter 的 Markdown 文件；仅用于文档说明的规则不会始终加载，也可以通过选项排除
+type ContinueAdapter struct {
+	groupBy              string
+	includeDocumentation bool
+}
+
+// NewContinueAdapter 创建新的Continue适配器
+func NewContinueAdapter() *ContinueAdapter {
+	return &ContinueAdapter{
+		groupBy:              GroupByRule,
+		includeDocumentation: true,
+	}
+}
+
+// Name 返回平台名称
+func (c *ContinueAdapter) Name() string {
+	return "continue"
+}
+
+// DefaultOutputPath 返回项目信息规则文件路径
+func (c *ContinueAdapter) DefaultOutputPath() string {
+	return path.Join(continueRulesDir, projectFileName+".md")
+}
+
+// Configure 应用平台选项
+//   - group_by: 分组方式 rule、type 或 source（默认 rule）
+//   - include_documentation: 是否输出仅用于文档说明的规则（默认 true）
+func (c *ContinueAdapter) Configure(options map[string]string) error {
+	if err := checkOptions(c.Name(), options, "group_by", "include_documentation"); err != nil {
+		return err
+	}
+
+	groupBy, err := enumOption(options, "group_by", c.groupBy, GroupByRule, GroupByType, GroupBySource)
+	if err != nil {
+		return err
+	}
+
+	includeDocumentation, err := boolOption(options, "include_documentation", c.includeDocumentation)
+	if err != nil {
+		return err
+	}
+
+	c.groupBy = groupBy
+	c.includeDocumentation = includeDocumentation
+	return nil
+}
+
+// Convert 将统一规则转换为Continue格式，返回项目信息文件和各分组的规则文件
+func (c *ContinueAdapter) Convert(ruleSet *rules.RuleSet) (*Output, error) {
+	ruleSet = withDefaultLanguage(ruleSet, rules.LangEN)
+	msg := messagesFor(ruleSet, rules.LangEN)
+
+	filtered := ruleSet
+	if !c.includeDocumentation {
+		filtered = filterRuleSet(ruleSet, func(rule rules.Rule) bool {
+			return !isDocumentationOnly(rule)
+		})
+	}
+
+	groups, err := GroupRules(filtered, c.groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	var project strings.Builder
+	writeContinueFrontMatter(&project, msg.text("title.project", ruleSet.Metadata.ProjectName), msg.text("project_rule_description"), nil, true)
+	project.WriteString(generatedNotice(msg, c.Name()))
+	project.WriteString("\n")
+	project.WriteString(fmt.Sprintf("# %s\n\n", ruleSet.Metadata.ProjectName))
+	writeProjectInfo(&project, msg, ruleSet.Metadata)
+
+	output := &Output{
+		Files: []OutputFile{{Path: c.DefaultOutputPath(), Content: markdownBytes(&project)}},
+		Owned: []string{path.Join(continueRulesDir, "*.md")},
+	}
+
+	for _, group := range groups {
+		activation, globs := groupActivation(group.Rules)
+
+		// 仅用于文档说明的规则只在相关时加载
+		documentationOnly := true
+		for _, rule := range group.Rules {
+			if !isDocumentationOnly(rule) {
+				documentationOnly = false
+			}
+		}
+		alwaysApply := activation == ActivationAlways && !documentationOnly
+
+		description := groupDescription(group)
+		if activation == ActivationManual {
+			description = ""
+		}
+
+		var content strings.Builder
+		writeContinueFrontMatter(&content, group.Title, description, globs, alwaysApply)
+		content.WriteString(generatedNotice(msg, c.Name()))
+		content.WriteString("\n")
+		if len(group.Rules) == 1 {
+			writeRuleMarkdown(&content, group.Rules[0], 1)
+		} else {
+			writeGroupMarkdown(&content, group, 1)
+		}
+
+		output.Files = append(output.Files, OutputFile{
+			Path:    path.Join(continueRulesDir, group.Name+".md"),
+			Content: markdownBytes(&content),
+		})
+	}
+
+	return output, nil
+}
+
+// isDocumentationOnly 判断规则是否仅用于文档说明（显式带有 docs-only 标签）
+// 不以 documentation 类型判断：代码注释规范等编码规则的类型同样是 documentation
+func isDocumentationOnly(rule rules.Rule) bool {
+	return hasTag(rule, "docs-only")
+}
+
+// writeContinueFrontMatter 写入 Continue 规则文件的 front matter
+// 字符串值使用双引号，globs 为空时省略
+func writeContinueFrontMatter(content *strings.Builder, name, description string, globs []string, alwaysApply bool) {
+	content.WriteString("---\n")
+	content.WriteString(fmt.Sprintf("name: %s\n", strconv.Quote(name)))
+	if len(globs) > 0 {
+		quoted := make([]string, 0, len(globs))
+		for _, glob := range globs {
+			quoted = append(quoted, strconv.Quote(glob))
+		}
+		content.WriteString(fmt.Sprintf("globs: [%s]\n", strings.Join(quoted, ", ")))
+	}
+	content.WriteString(fmt.Sprintf("alwaysApply: %t\n", alwaysApply))
+	if description = strings.Join(strings.Fields(description), " "); description != "" {
+		content.WriteString(fmt.Sprintf("description: %s\n", strconv.Quote(description)))
+	}
+	content.WriteString("---\n\n")
+}
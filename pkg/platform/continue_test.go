@@ -0,0 +1,59 @@
+package platform
+
+import (
+	"testing"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+func TestContinueAdapterConvert(t *testing.T) {
+	docsRuleSet := testRuleSet()
+	docsRuleSet.GlobalRules = []rules.Rule{
+		{Title: "Comments", Type: "documentation", Content: "Comment exported functions.", Priority: 4, Enabled: true},
+		{Title: "Docs Site", Type: "documentation", Tags: []string{"docs-only"}, Content: "Docs live in site/.", Priority: 5, Enabled: true},
+	}
+
+	runAdapterCases(t, func() PlatformAdapter { return NewContinueAdapter() }, []adapterCase{
+		{
+			name: "按规则生成 front matter",
+			paths: []string{
+				".continue/rules/00-project.md",
+				".continue/rules/security.md",
+				".continue/rules/api-handlers.md",
+				".continue/rules/naming.md",
+			},
+			contains: map[string][]string{
+				".continue/rules/00-project.md":   {"---\nname: \"demo Project\"\nalwaysApply: true\n"},
+				".continue/rules/security.md":     {"---\nname: \"Security\"\nalwaysApply: true\n", GeneratedMarker, "Never hardcode secrets."},
+				".continue/rules/api-handlers.md": {"---\nname: \"API Handlers\"\nglobs: [\"services/api/**/*.go\"]\nalwaysApply: false\ndescription: \"HTTP handler conventions\"\n---\n"},
+				".continue/rules/naming.md":       {"---\nname: \"Naming\"\nalwaysApply: false\ndescription: \"Naming conventions\"\n---\n"},
+			},
+		},
+		{
+			name:    "docs-only 规则不会始终加载",
+			ruleSet: docsRuleSet,
+			paths: []string{
+				".continue/rules/00-project.md",
+				".continue/rules/security.md",
+				".continue/rules/api-handlers.md",
+				".continue/rules/comments.md",
+				".continue/rules/docs-site.md",
+			},
+			contains: map[string][]string{
+				".continue/rules/comments.md":  {"alwaysApply: true\n"},
+				".continue/rules/docs-site.md": {"alwaysApply: false\n"},
+			},
+		},
+		{
+			name:    "排除 docs-only 规则",
+			ruleSet: docsRuleSet,
+			options: map[string]string{"include_documentation": "false"},
+			paths: []string{
+				".continue/rules/00-project.md",
+				".continue/rules/security.md",
+				".continue/rules/api-handlers.md",
+				".continue/rules/comments.md",
+			},
+		},
+	})
+}
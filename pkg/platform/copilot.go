@@ -0,0 +1,153 @@
+package platform
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+// Copilot 指令文件的实用大小限制
+// Copilot code review 只读取每个指令文件的前 4000 个字符；
+// 指令总量过大时会挤占对话上下文，因此对合计大小也给出警告
+const (
+	copilotMaxFileChars  = 4000
+	copilotMaxTotalChars = 16000
+)
+
+// copilotInstructionsDir 按路径生效的指令文件目录
+const copilotInstructionsDir = ".github/instructions"
+
+// CopilotAdapter GitHub Copilot平台适配器
+// 无作用范围的规则写入 .github/copilot-instructions.md；
+// 带 globs 的规则写入 .github/instructions/<name>.instructions.md，并通过 applyTo 限定生效文件
+type CopilotAdapter struct {
+	maxFileChars  int
+	maxTotalChars int
+}
+
+// NewCopilotAdapter 创建新的GitHub Copilot适配器
+func NewCopilotAdapter() *CopilotAdapter {
+	return &CopilotAdapter{
+		maxFileChars:  copilotMaxFileChars,
+		maxTotalChars: copilotMaxTotalChars,
+	}
+}
+
+// Name 返回平台名称
+func (c *CopilotAdapter) Name() string {
+	return "copilot"
+}
+
+// DefaultOutputPath 返回Copilot仓库级指令文件路径
+func (c *CopilotAdapter) DefaultOutputPath() string {
+	return ".github/copilot-instructions.md"
+}
+
+// Configure 应用平台选项
+//   - max_file_chars: 单个指令文件的字符数警告阈值（默认 4000）
+//   - max_total_chars: 全部指令文件合计字符数警告阈值（默认 16000）
+func (c *CopilotAdapter) Configure(options map[string]string) error {
+	if err := checkOptions(c.Name(), options, "max_file_chars", "max_total_chars"); err != nil {
+		return err
+	}
+
+	limits := []struct {
+		key    string
+		target *int
+	}{
+		{key: "max_file_chars", target: &c.maxFileChars},
+		{key: "max_total_chars", target: &c.maxTotalChars},
+	}
+	for _, limit := range limits {
+		key := limit.key
+		value, exists := options[key]
+		if !exists || value == "" {
+			continue
+		}
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			return fmt.Errorf("选项 \"%s\" 的值 \"%s\" 不是有效的正整数", key, value)
+		}
+		*limit.target = parsed
+	}
+
+	return nil
+}
+
+// Convert 将统一规则转换为Copilot格式，返回仓库级指令文件和按路径生效的指令文件
+func (c *CopilotAdapter) Convert(ruleSet *rules.RuleSet) (*Output, error) {
+	ruleSet = withDefaultLanguage(ruleSet, rules.LangEN)
+	msg := messagesFor(ruleSet, rules.LangEN)
+
+	// 仓库级指令只包含无作用范围的规则
+	groups, err := GroupRules(filterRuleSet(ruleSet, isUnscoped), GroupBySource)
+	if err != nil {
+		return nil, err
+	}
+
+	var content strings.Builder
+	content.WriteString("# " + msg.text("title.copilot_instructions", ruleSet.Metadata.ProjectName) + "\n\n")
+	content.WriteString(generatedNotice(msg, c.Name()))
+	content.WriteString("\n")
+	writeProjectInfo(&content, msg, ruleSet.Metadata)
+
+	for _, group := range groups {
+		writeGroupMarkdown(&content, group, 2)
+	}
+
+	output := &Output{
+		Files: []OutputFile{{Path: c.DefaultOutputPath(), Content: markdownBytes(&content)}},
+		Owned: []string{path.Join(copilotInstructionsDir, "*.instructions.md")},
+	}
+
+	// 带作用范围的规则各自生成一个路径指令文件
+	ruleGroups, err := GroupRules(filterRuleSet(ruleSet, isScoped), GroupByRule)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range ruleGroups {
+		rule := group.Rules[0]
+
+		var instructions strings.Builder
+		instructions.WriteString("---\n")
+		instructions.WriteString(fmt.Sprintf("applyTo: %s\n", strconv.Quote(strings.Join(rule.Globs, ","))))
+		instructions.WriteString("---\n\n")
+		instructions.WriteString(generatedNotice(msg, c.Name()))
+		instructions.WriteString("\n")
+		writeRuleMarkdown(&instructions, rule, 1)
+
+		output.Files = append(output.Files, OutputFile{
+			Path:    path.Join(copilotInstructionsDir, group.Name+".instructions.md"),
+			Content: markdownBytes(&instructions),
+		})
+	}
+
+	output.Warnings = c.checkSize(output.Files)
+	return output, nil
+}
+
+// checkSize 检查指令文件是否超出Copilot的实用大小限制
+func (c *CopilotAdapter) checkSize(files []OutputFile) []string {
+	var warnings []string
+	total := 0
+
+	for _, file := range files {
+		chars := utf8.RuneCount(file.Content)
+		total += chars
+		if chars > c.maxFileChars {
+			warnings = append(warnings, fmt.Sprintf("%s 共 %d 个字符，超过建议上限 %d（Copilot code review 只读取指令文件的前 %d 个字符）",
+				file.Path, chars, c.maxFileChars, copilotMaxFileChars))
+		}
+	}
+
+	if total > c.maxTotalChars {
+		warnings = append(warnings, fmt.Sprintf("Copilot 指令文件合计 %d 个字符，超过建议上限 %d，过长的指令会挤占对话上下文",
+			total, c.maxTotalChars))
+	}
+
+	return warnings
+}
@@ -0,0 +1,50 @@
+package platform
+
+import "testing"
+
+func TestCopilotAdapterConvert(t *testing.T) {
+	runAdapterCases(t, func() PlatformAdapter { return NewCopilotAdapter() }, []adapterCase{
+		{
+			name:  "仓库级和按路径生效的指令",
+			paths: []string{".github/copilot-instructions.md", ".github/instructions/api-handlers.instructions.md"},
+			contains: map[string][]string{
+				".github/copilot-instructions.md":                   {GeneratedMarker, "Never hardcode secrets.", "Use camelCase."},
+				".github/instructions/api-handlers.instructions.md": {"---\napplyTo: \"services/api/**/*.go\"\n---\n", "Return JSON errors."},
+			},
+			excludes: map[string][]string{
+				".github/copilot-instructions.md": {"Return JSON errors.", "Never shown."},
+			},
+		},
+		{
+			name:     "单个文件超出大小上限",
+			options:  map[string]string{"max_file_chars": "250"},
+			paths:    []string{".github/copilot-instructions.md", ".github/instructions/api-handlers.instructions.md"},
+			warnings: 1,
+		},
+		{
+			name:     "单个文件和合计均超出大小上限",
+			options:  map[string]string{"max_file_chars": "100", "max_total_chars": "300"},
+			paths:    []string{".github/copilot-instructions.md", ".github/instructions/api-handlers.instructions.md"},
+			warnings: 3,
+		},
+	})
+}
+
+func TestCopilotAdapterOwned(t *testing.T) {
+	output := convertWith(t, NewCopilotAdapter(), nil, testRuleSet())
+	if len(output.Owned) != 1 || output.Owned[0] != ".github/instructions/*.instructions.md" {
+		t.Errorf("Owned = %q，期望 [.github/instructions/*.instructions.md]", output.Owned)
+	}
+}
+
+func TestCopilotAdapterConfigure(t *testing.T) {
+	for _, options := range []map[string]string{
+		{"max_file_chars": "0"},
+		{"max_total_chars": "abc"},
+		{"unknown": "1"},
+	} {
+		if err := NewCopilotAdapter().Configure(options); err == nil {
+			t.Errorf("Configure(%v) 应返回错误", options)
+		}
+	}
+}
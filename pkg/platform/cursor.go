@@ -2,18 +2,37 @@ package platform
 
 import (
 	"fmt"
+	"os"
+	"path"
 	"strings"
-	"time"
 
 	"github/pfinal/pf_ruler/pkg/rules"
 )
 
+// Cursor 输出模式
+const (
+	// CursorModeMDC 在 .cursor/rules/ 下为每条规则（或每个分组）生成带 front matter 的 .mdc 文件
+	CursorModeMDC = "mdc"
+
+	// CursorModeLegacy 生成项目根目录的单个 .cursorrules 文件
+	CursorModeLegacy = "legacy"
+)
+
+// cursorRulesDir .mdc 规则文件所在目录
+const cursorRulesDir = ".cursor/rules"
+
 // CursorAdapter Cursor平台适配器
-type CursorAdapter struct{}
+type CursorAdapter struct {
+	mode    string
+	groupBy string
+}
 
 // NewCursorAdapter 创建新的Cursor适配器
 func NewCursorAdapter() *CursorAdapter {
-	return &CursorAdapter{}
+	return &CursorAdapter{
+		mode:    CursorModeMDC,
+		groupBy: GroupByRule,
+	}
 }
 
 // Name 返回平台名称
@@ -22,100 +41,201 @@ func (c *CursorAdapter) Name() string {
 }
 
 // DefaultOutputPath 返回Cursor规则默认输出路径
-// Cursor编辑器使用 .cursorrules 文件，放在项目根目录
+// mdc 模式下为项目信息规则文件，legacy 模式下为项目根目录的 .cursorrules 文件
 func (c *CursorAdapter) DefaultOutputPath() string {
-	return ".cursorrules"
+	if c.mode == CursorModeLegacy {
+		return ".cursorrules"
+	}
+	return path.Join(cursorRulesDir, projectFileName+".mdc")
+}
+
+// Configure 应用平台选项
+//   - mode: 输出模式 mdc 或 legacy（默认 mdc）
+//   - group_by: mdc 模式下的分组方式 rule、type 或 source（默认 rule）
+func (c *CursorAdapter) Configure(options map[string]string) error {
+	if err := checkOptions(c.Name(), options, "mode", "group_by"); err != nil {
+		return err
+	}
+
+	mode, err := enumOption(options, "mode", c.mode, CursorModeMDC, CursorModeLegacy)
+	if err != nil {
+		return err
+	}
+
+	groupBy, err := enumOption(options, "group_by", c.groupBy, GroupByRule, GroupByType, GroupBySource)
+	if err != nil {
+		return err
+	}
+
+	c.mode = mode
+	c.groupBy = groupBy
+	return nil
 }
 
 // Convert 将统一规则转换为Cursor格式
-// Cursor使用纯文本格式，每行一个规则
-func (c *CursorAdapter) Convert(ruleSet *rules.RuleSet) ([]byte, error) {
-	var content strings.Builder
-	
-	// 写入文件头部说明
-	content.WriteString(fmt.Sprintf("# Cursor Rules for %s\n", ruleSet.Metadata.ProjectName))
-	content.WriteString(fmt.Sprintf("# Generated by pf_ruler on %s\n\n", time.Now().Format("2006-01-02 15:04:05")))
-	
-	// 写入项目信息
-	content.WriteString("## Project Information\n")
-	content.WriteString(fmt.Sprintf("Project: %s\n", ruleSet.Metadata.ProjectName))
-	if len(ruleSet.Metadata.TechStacks) > 0 {
-		content.WriteString(fmt.Sprintf("Tech Stack: %s\n", strings.Join(ruleSet.Metadata.TechStacks, ", ")))
+// 两种模式都声明拥有 .cursor/rules/*.mdc，切换模式或删除规则后，之前生成的文件会被清理；
+// .cursorrules 只在 legacy 模式下拥有，默认模式不会删除已有的 .cursorrules
+func (c *CursorAdapter) Convert(ruleSet *rules.RuleSet) (*Output, error) {
+	ruleSet = withDefaultLanguage(ruleSet, rules.LangEN)
+	msg := messagesFor(ruleSet, rules.LangEN)
+
+	output := &Output{
+		Owned: []string{path.Join(cursorRulesDir, "*.mdc")},
 	}
-	content.WriteString("\n")
-	
-	// 写入项目规则（最高优先级）
-	if len(ruleSet.ProjectRules) > 0 {
-		content.WriteString("## Project-Specific Rules (Highest Priority)\n\n")
-		
-		for _, rule := range ruleSet.ProjectRules {
-			if !rule.Enabled {
-				continue
-			}
-			
-			content.WriteString(fmt.Sprintf("### %s\n", rule.Title))
-			content.WriteString(fmt.Sprintf("Type: %s | Priority: %d | Tags: %s\n", 
-				rule.Type, rule.Priority, strings.Join(rule.Tags, ", ")))
-			content.WriteString(fmt.Sprintf("Description: %s\n", rule.Description))
-			content.WriteString(fmt.Sprintf("Rule: %s\n\n", rule.Content))
+
+	if c.mode == CursorModeLegacy {
+		templates, err := loadPlatformTemplates(c.Name(), msg)
+		if err != nil {
+			return nil, err
 		}
-	}
-	
-	// 写入全局规则（次优先级）
-	if len(ruleSet.GlobalRules) > 0 {
-		content.WriteString("## Global Rules (Medium Priority)\n\n")
-		
-		for _, rule := range ruleSet.GlobalRules {
-			if !rule.Enabled {
-				continue
-			}
-			
-			content.WriteString(fmt.Sprintf("### %s\n", rule.Title))
-			content.WriteString(fmt.Sprintf("Type: %s | Priority: %d | Tags: %s\n", 
-				rule.Type, rule.Priority, strings.Join(rule.Tags, ", ")))
-			content.WriteString(fmt.Sprintf("Description: %s\n", rule.Description))
-			content.WriteString(fmt.Sprintf("Rule: %s\n\n", rule.Content))
+		content, err := c.convertLegacy(ruleSet, msg, templates)
+		if err != nil {
+			return nil, err
 		}
+		output.Files = []OutputFile{{Path: c.DefaultOutputPath(), Content: content}}
+		output.Owned = append(output.Owned, ".cursorrules")
+		return output, nil
+	}
+
+	templates, err := loadPlatformTemplates(c.Name()+"."+CursorModeMDC, msg)
+	if err != nil {
+		return nil, err
+	}
+	files, err := c.convertMDC(ruleSet, msg, templates)
+	if err != nil {
+		return nil, err
 	}
-	
-	// 写入模板规则（可选）
-	if len(ruleSet.TemplateRules) > 0 {
-		content.WriteString("## Custom Template Rules\n\n")
-		
-		for _, rule := range ruleSet.TemplateRules {
-			if !rule.Enabled {
-				continue
+	output.Files = files
+	return output, nil
+}
+
+// cursorMDCTemplateData 项目信息规则文件模板（cursor.mdc.tmpl）的数据
+type cursorMDCTemplateData struct {
+	Platform string
+	Project  rules.Metadata
+
+	// 生成标记，清理过期文件时据此识别由 pf_ruler 生成的文件
+	Marker string
+
+	// .mdc 规则文件所在目录
+	RulesDir string
+
+	// 按 rule_priority 排列的规则来源标题
+	Sources []string
+}
+
+// convertMDC 生成 .cursor/rules/*.mdc 文件
+// 第一个文件为始终加载的项目信息，其余文件按分组生成，front matter 由规则的优先级、标签和作用范围推断；
+// front matter 之后的正文由 cursor.mdc.tmpl（项目信息）和 cursor.mdc.rule.tmpl（每条规则）渲染，两者均可在 .ruler/templates 中覆盖
+func (c *CursorAdapter) convertMDC(ruleSet *rules.RuleSet, msg *messages, templates *platformTemplates) ([]OutputFile, error) {
+	groups, err := GroupRules(ruleSet, c.groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make([]string, 0, len(ruleSet.Sections()))
+	for _, section := range ruleSet.Sections() {
+		sources = append(sources, msg.sourceTitle(section.Source))
+	}
+	body, err := templates.renderFile(cursorMDCTemplateData{
+		Platform: c.Name(),
+		Project:  ruleSet.Metadata,
+		Marker:   GeneratedMarker,
+		RulesDir: cursorRulesDir,
+		Sources:  sources,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var project strings.Builder
+	writeCursorFrontMatter(&project, msg.text("project_rule_description"), nil, true)
+	project.Write(body)
+
+	files := []OutputFile{{Path: c.DefaultOutputPath(), Content: markdownBytes(&project)}}
+
+	for _, group := range groups {
+		activation, globs := groupActivation(group.Rules)
+
+		var content strings.Builder
+		description := groupDescription(group)
+		if activation == ActivationManual {
+			description = ""
+		}
+		writeCursorFrontMatter(&content, description, globs, activation == ActivationAlways)
+		content.WriteString(generatedNotice(msg, c.Name()))
+		content.WriteString("\n")
+
+		// 只有一条规则的分组以规则标题作为一级标题，多条规则时以分组标题作为一级标题
+		level := 1
+		if len(group.Rules) > 1 {
+			content.WriteString(fmt.Sprintf("# %s\n\n", group.Title))
+			level = 2
+		}
+		for _, rule := range group.Rules {
+			rendered, err := templates.renderRule(rule, level)
+			if err != nil {
+				return nil, err
 			}
-			
-			content.WriteString(fmt.Sprintf("### %s\n", rule.Title))
-			content.WriteString(fmt.Sprintf("Type: %s | Priority: %d | Tags: %s\n", 
-				rule.Type, rule.Priority, strings.Join(rule.Tags, ", ")))
-			content.WriteString(fmt.Sprintf("Description: %s\n", rule.Description))
-			content.WriteString(fmt.Sprintf("Rule: %s\n\n", rule.Content))
+			content.WriteString(rendered)
 		}
+
+		files = append(files, OutputFile{
+			Path:    path.Join(cursorRulesDir, group.Name+".mdc"),
+			Content: markdownBytes(&content),
+		})
 	}
-	
-	// 写入使用说明
-	content.WriteString("## Usage Instructions\n\n")
-	content.WriteString("This rules file is automatically generated by pf_ruler tool.\n")
-	content.WriteString("It guides the AI editor to generate code that follows project standards.\n\n")
-	
-	content.WriteString("### Rule Priority\n")
-	content.WriteString("1. Project-specific rules - Highest priority, override other rules\n")
-	content.WriteString("2. Global rules - Medium priority, apply to all projects\n")
-	content.WriteString("3. Template rules - Optional, from user configuration\n\n")
-	
-	content.WriteString("### Updating Rules\n")
-	content.WriteString("To update rules, modify the corresponding files in the .ruler directory,\n")
-	content.WriteString("then re-run: pf_ruler generate --platform=cursor\n")
-	
-	return []byte(content.String()), nil
+
+	return files, nil
+}
+
+// writeCursorFrontMatter 写入 .mdc 文件的 front matter
+// Cursor 按原样读取字段值，因此不对 globs 加引号，描述中的换行替换为空格
+func writeCursorFrontMatter(content *strings.Builder, description string, globs []string, alwaysApply bool) {
+	content.WriteString("---\n")
+	content.WriteString(fmt.Sprintf("description: %s\n", strings.Join(strings.Fields(description), " ")))
+	content.WriteString(fmt.Sprintf("globs: %s\n", strings.Join(globs, ",")))
+	content.WriteString(fmt.Sprintf("alwaysApply: %t\n", alwaysApply))
+	content.WriteString("---\n\n")
+}
+
+// cursorTemplateData .cursorrules 模板（cursor.tmpl）的数据
+type cursorTemplateData struct {
+	Platform string
+	Project  rules.Metadata
+
+	// SOURCE_DATE_EPOCH 指定的生成时间（UTC），未设置时为空
+	GeneratedAt string
+
+	// 生成标记，清理过期文件时据此识别由 pf_ruler 生成的文件
+	Marker string
+
+	// 按 rule_priority 排列的规则来源
+	Sections []templateSection
+}
+
+// convertLegacy 生成 legacy 模式的 .cursorrules 内容
+// 文件由 cursor.tmpl 渲染，每条规则由 cursor.rule.tmpl 渲染，两者均可在 .ruler/templates 中覆盖
+func (c *CursorAdapter) convertLegacy(ruleSet *rules.RuleSet, msg *messages, templates *platformTemplates) ([]byte, error) {
+	return templates.renderFile(cursorTemplateData{
+		Platform:    c.Name(),
+		Project:     ruleSet.Metadata,
+		GeneratedAt: generatedAt(ruleSet.Metadata),
+		Marker:      GeneratedMarker,
+		Sections:    templateSections(ruleSet, msg, "cursor.note"),
+	})
 }
 
 // EnsureOutputDirectory 确保输出目录存在
-// 对于Cursor，文件直接放在项目根目录，不需要创建子目录
+// legacy 模式的 .cursorrules 直接放在项目根目录，mdc 模式需要创建 .cursor/rules 目录
 func (c *CursorAdapter) EnsureOutputDirectory() error {
-	// Cursor使用 .cursorrules 文件，直接放在项目根目录
-	// 不需要创建额外的目录结构
+	if c.mode == CursorModeLegacy {
+		return nil
+	}
+
+	if err := os.MkdirAll(cursorRulesDir, 0755); err != nil {
+		return fmt.Errorf("创建输出目录失败: %w", err)
+	}
+
 	return nil
 }
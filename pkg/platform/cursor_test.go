@@ -0,0 +1,132 @@
+package platform
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+func TestCursorAdapterConvert(t *testing.T) {
+	translatedRuleSet := testRuleSet()
+	translatedRuleSet.ProjectRules[0].Content = "不要硬编码密钥。"
+	translatedRuleSet.ProjectRules[0].Translations = map[string]rules.RuleTranslation{
+		rules.LangEN: {Content: "Never hardcode secrets."},
+	}
+
+	zhRuleSet := testRuleSet()
+	zhRuleSet.ProjectRules[0] = translatedRuleSet.ProjectRules[0]
+	zhRuleSet.Language = rules.LangZhCN
+
+	runAdapterCases(t, func() PlatformAdapter { return NewCursorAdapter() }, []adapterCase{
+		{
+			name: "mdc 模式按规则生成",
+			paths: []string{
+				".cursor/rules/00-project.mdc",
+				".cursor/rules/security.mdc",
+				".cursor/rules/api-handlers.mdc",
+				".cursor/rules/naming.mdc",
+			},
+			contains: map[string][]string{
+				".cursor/rules/00-project.mdc":   {"globs: \nalwaysApply: true\n", "# demo"},
+				".cursor/rules/security.mdc":     {"globs: \nalwaysApply: true\n---\n", GeneratedMarker, "Never hardcode secrets."},
+				".cursor/rules/api-handlers.mdc": {"description: HTTP handler conventions\nglobs: services/api/**/*.go\nalwaysApply: false\n"},
+				".cursor/rules/naming.mdc":       {"description: Naming conventions\nglobs: \nalwaysApply: false\n"},
+			},
+		},
+		{
+			name:    "mdc 模式按来源分组",
+			options: map[string]string{"group_by": "source"},
+			paths:   []string{".cursor/rules/00-project.mdc", ".cursor/rules/project.mdc", ".cursor/rules/global.mdc"},
+			contains: map[string][]string{
+				".cursor/rules/project.mdc": {"alwaysApply: true\n", "Never hardcode secrets.", "Return JSON errors."},
+				".cursor/rules/global.mdc":  {"alwaysApply: false\n", "Use camelCase."},
+			},
+		},
+		{
+			name:    "legacy 模式",
+			options: map[string]string{"mode": "legacy"},
+			paths:   []string{".cursorrules"},
+			contains: map[string][]string{
+				".cursorrules": {GeneratedMarker, "Never hardcode secrets.", "Use camelCase."},
+			},
+			excludes: map[string][]string{".cursorrules": {"Never shown.", "alwaysApply"}},
+		},
+		{
+			name:     "未指定输出语言时使用默认语言的翻译",
+			ruleSet:  translatedRuleSet,
+			paths:    []string{".cursor/rules/00-project.mdc", ".cursor/rules/security.mdc", ".cursor/rules/api-handlers.mdc", ".cursor/rules/naming.mdc"},
+			contains: map[string][]string{".cursor/rules/security.mdc": {"Never hardcode secrets."}},
+			excludes: map[string][]string{".cursor/rules/security.mdc": {"不要硬编码密钥。"}},
+		},
+		{
+			name:    "指定输出语言时保留该语言的正文",
+			ruleSet: zhRuleSet,
+			paths:   []string{".cursor/rules/00-project.mdc", ".cursor/rules/security.mdc", ".cursor/rules/api-handlers.mdc", ".cursor/rules/naming.mdc"},
+			contains: map[string][]string{
+				".cursor/rules/00-project.mdc": {"项目信息"},
+				".cursor/rules/security.mdc":   {"不要硬编码密钥。"},
+			},
+		},
+	})
+}
+
+func TestCursorAdapterOwned(t *testing.T) {
+	tests := []struct {
+		mode  string
+		owned []string
+	}{
+		{mode: "mdc", owned: []string{".cursor/rules/*.mdc"}},
+		{mode: "legacy", owned: []string{".cursor/rules/*.mdc", ".cursorrules"}},
+	}
+
+	for _, tt := range tests {
+		output := convertWith(t, NewCursorAdapter(), map[string]string{"mode": tt.mode}, testRuleSet())
+		if !equalStrings(output.Owned, tt.owned) {
+			t.Errorf("%s 模式的 Owned = %q，期望 %q", tt.mode, output.Owned, tt.owned)
+		}
+	}
+}
+
+func TestCursorAdapterTemplateOverride(t *testing.T) {
+	dir := t.TempDir()
+	overrides := map[string]string{
+		"cursor.mdc.tmpl":      "# {{ .Project.ProjectName }} ({{ join .Sources \", \" }})\n{{ .Marker }}\n",
+		"cursor.mdc.rule.tmpl": "{{ .Heading }} [{{ .Type }}] {{ .Title }}\n\n{{ .Content }}\n\n",
+		"cursor.rule.tmpl":     "{{ .Heading }} legacy {{ .Title }}\n",
+	}
+	for name, content := range overrides {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	original := TemplateOverrideDir
+	TemplateOverrideDir = dir
+	defer func() { TemplateOverrideDir = original }()
+
+	runAdapterCases(t, func() PlatformAdapter { return NewCursorAdapter() }, []adapterCase{
+		{
+			name: "mdc 模式使用 cursor.mdc 模板",
+			paths: []string{
+				".cursor/rules/00-project.mdc",
+				".cursor/rules/security.mdc",
+				".cursor/rules/api-handlers.mdc",
+				".cursor/rules/naming.mdc",
+			},
+			contains: map[string][]string{
+				".cursor/rules/00-project.mdc": {"alwaysApply: true\n---\n\n# demo (Project-Specific Rules, Global Rules)\n" + GeneratedMarker + "\n"},
+				".cursor/rules/security.mdc":   {"alwaysApply: true\n---\n", GeneratedMarker, "# [security] Security\n\nNever hardcode secrets.\n"},
+				".cursor/rules/naming.mdc":     {"# [naming] Naming\n\nUse camelCase.\n"},
+			},
+			excludes: map[string][]string{".cursor/rules/security.mdc": {"legacy"}},
+		},
+		{
+			name:     "legacy 模式使用 cursor.rule.tmpl",
+			options:  map[string]string{"mode": "legacy"},
+			paths:    []string{".cursorrules"},
+			contains: map[string][]string{".cursorrules": {"### legacy Security\n"}},
+		},
+	})
+}
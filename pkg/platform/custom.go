@@ -0,0 +1,346 @@
+package platform
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+// CustomPlatformDir 自定义平台声明所在目录（相对 .ruler）
+const CustomPlatformDir = "platforms"
+
+// templateActionPattern 匹配路径模式中的模板动作，用于推导清理过期文件的 glob 模式
+var templateActionPattern = regexp.MustCompile(`\{\{.*?\}\}`)
+
+// CustomPlatformConfig .ruler/platforms/<name>.yaml 中的自定义平台声明
+type CustomPlatformConfig struct {
+	// 平台名称，省略时使用文件名
+	Name string `yaml:"name"`
+
+	// 单文件输出路径，与 path 二选一
+	Output string `yaml:"output"`
+
+	// 按分组输出时的路径模式（text/template），如 .acme/rules/{{ .Group.Name }}.md，与 output 二选一
+	Path string `yaml:"path"`
+
+	// 分组方式 source、type 或 rule；单文件默认 source，按分组输出默认 rule
+	GroupBy string `yaml:"group_by"`
+
+	// 文件开头的 front matter，值为 text/template，渲染结果为空的字段会被省略
+	FrontMatter yaml.Node `yaml:"front_matter"`
+
+	// 文件正文模板（text/template）
+	Template string `yaml:"template"`
+}
+
+// CustomAdapter 由 YAML 声明的自定义平台适配器
+type CustomAdapter struct {
+	name        string
+	output      string
+	pathPattern *template.Template
+	rawPath     string
+	groupBy     string
+	frontMatter []customField
+	body        *template.Template
+	options     map[string]string
+}
+
+// customField front matter 中的一个字段
+type customField struct {
+	key   string
+	value *template.Template
+}
+
+// customTemplateData 传给自定义平台模板的数据
+type customTemplateData struct {
+	// 平台名称
+	Platform string
+
+	// 项目元数据
+	Project rules.Metadata
+
+	// 包含 GeneratedMarker 的生成说明，写入文件后重新生成时才能清理过期文件
+	Notice string
+
+	// 平台选项（config.yaml 的 platforms.<name>.options）
+	Options map[string]string
+
+	// 当前文件的规则（单文件输出时为全部已启用的规则）
+	Rules []rules.Rule
+
+	// 全部规则分组（单文件输出时可用）
+	Groups []RuleGroup
+
+	// 当前分组（按分组输出时可用）
+	Group RuleGroup
+
+	// 当前分组的激活方式（always、glob、model_decision、manual）、globs 和描述
+	Activation  string
+	Globs       []string
+	Description string
+}
+
+// LoadCustomPlatforms 加载 rulerDir/platforms 下的全部自定义平台声明，按文件名排序
+// 目录不存在时返回空列表
+func LoadCustomPlatforms(rulerDir string) ([]*CustomAdapter, error) {
+	dir := filepath.Join(rulerDir, CustomPlatformDir)
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("读取自定义平台目录失败: %w", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if !entry.IsDir() && (ext == ".yaml" || ext == ".yml") {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	adapters := make([]*CustomAdapter, 0, len(names))
+	for _, name := range names {
+		filePath := filepath.Join(dir, name)
+		adapter, err := loadCustomPlatform(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("自定义平台 %s 无效: %w", filePath, err)
+		}
+		adapters = append(adapters, adapter)
+	}
+
+	return adapters, nil
+}
+
+// loadCustomPlatform 解析并校验单个自定义平台声明
+func loadCustomPlatform(filePath string) (*CustomAdapter, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var config CustomPlatformConfig
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&config); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("解析失败（请检查是否包含未知配置项）: %w", err)
+	}
+
+	adapter := &CustomAdapter{
+		name:    strings.ToLower(strings.TrimSpace(config.Name)),
+		output:  strings.TrimSpace(config.Output),
+		rawPath: strings.TrimSpace(config.Path),
+	}
+	if adapter.name == "" {
+		adapter.name = strings.ToLower(strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath)))
+	}
+	if strings.ContainsAny(adapter.name, ", ") {
+		return nil, fmt.Errorf("平台名称 \"%s\" 不能包含逗号或空格", adapter.name)
+	}
+	if adapter.name == AllPlatforms {
+		return nil, fmt.Errorf("平台名称 \"%s\" 是保留名称（--platform=%s 表示全部平台）", adapter.name, AllPlatforms)
+	}
+
+	switch {
+	case adapter.output == "" && adapter.rawPath == "":
+		return nil, fmt.Errorf("必须声明 output（单文件）或 path（按分组输出）")
+	case adapter.output != "" && adapter.rawPath != "":
+		return nil, fmt.Errorf("output 和 path 只能声明一个")
+	}
+
+	// 输出路径和由 path 推导出的清理范围都必须位于项目目录内
+	if adapter.output != "" {
+		if err := checkPluginPath(adapter.output); err != nil {
+			return nil, fmt.Errorf("output 无效: %w", err)
+		}
+	}
+	if adapter.rawPath != "" {
+		owned := adapter.ownedPattern()
+		if err := checkPluginPath(owned); err != nil {
+			return nil, fmt.Errorf("path 无效: %w", err)
+		}
+		// 清理过期文件时会删除 owned 模式匹配的生成文件，模式位于项目根目录或目录中含模板动作时，
+		// 可能匹配到其他平台生成的文件（如 CLAUDE.md），因此按分组输出的文件必须位于固定目录下
+		if dir := path.Dir(owned); dir == "." || strings.ContainsAny(dir, "*?[") {
+			return nil, fmt.Errorf("path \"%s\" 的目录部分必须是固定的专用目录，不能位于项目根目录或包含模板动作（如 .acme/rules/{{ .Group.Name }}.md）", adapter.rawPath)
+		}
+	}
+
+	defaultGroupBy := GroupBySource
+	if adapter.rawPath != "" {
+		defaultGroupBy = GroupByRule
+		if adapter.pathPattern, err = template.New("path").Funcs(templateFuncs).Parse(adapter.rawPath); err != nil {
+			return nil, fmt.Errorf("path 模板无效: %w", err)
+		}
+	}
+
+	adapter.groupBy = defaultGroupBy
+	if config.GroupBy != "" {
+		switch config.GroupBy {
+		case GroupBySource, GroupByType, GroupByRule:
+			adapter.groupBy = config.GroupBy
+		default:
+			return nil, fmt.Errorf("group_by 的值 \"%s\" 无效，可选值：%s, %s, %s",
+				config.GroupBy, GroupBySource, GroupByType, GroupByRule)
+		}
+	}
+
+	if strings.TrimSpace(config.Template) == "" {
+		return nil, fmt.Errorf("必须声明 template")
+	}
+	if adapter.body, err = template.New("template").Funcs(templateFuncs).Parse(config.Template); err != nil {
+		return nil, fmt.Errorf("template 无效: %w", err)
+	}
+
+	// 按声明顺序解析 front matter 字段
+	if config.FrontMatter.Kind != 0 {
+		if config.FrontMatter.Kind != yaml.MappingNode {
+			return nil, fmt.Errorf("front_matter 必须是键值映射")
+		}
+		for i := 0; i+1 < len(config.FrontMatter.Content); i += 2 {
+			key, value := config.FrontMatter.Content[i].Value, config.FrontMatter.Content[i+1]
+			if value.Kind != yaml.ScalarNode {
+				return nil, fmt.Errorf("front_matter.%s 必须是字符串模板", key)
+			}
+			field, err := template.New(key).Funcs(templateFuncs).Parse(value.Value)
+			if err != nil {
+				return nil, fmt.Errorf("front_matter.%s 模板无效: %w", key, err)
+			}
+			adapter.frontMatter = append(adapter.frontMatter, customField{key: key, value: field})
+		}
+	}
+
+	return adapter, nil
+}
+
+// Name 返回平台名称
+func (c *CustomAdapter) Name() string {
+	return c.name
+}
+
+// DefaultOutputPath 返回单文件输出路径；按分组输出时返回路径模式
+func (c *CustomAdapter) DefaultOutputPath() string {
+	if c.output != "" {
+		return c.output
+	}
+	return c.rawPath
+}
+
+// Configure 保存平台选项，模板中通过 .Options 访问
+func (c *CustomAdapter) Configure(options map[string]string) error {
+	c.options = options
+	return nil
+}
+
+// Convert 使用声明的模板渲染规则
+func (c *CustomAdapter) Convert(ruleSet *rules.RuleSet) (*Output, error) {
+	ruleSet = withDefaultLanguage(ruleSet, rules.LangEN)
+	msg := messagesFor(ruleSet, rules.LangEN)
+
+	groups, err := GroupRules(ruleSet, c.groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	base := customTemplateData{
+		Platform: c.name,
+		Project:  ruleSet.Metadata,
+		Notice:   generatedNotice(msg, c.name),
+		Options:  c.options,
+	}
+
+	if c.output != "" {
+		data := base
+		data.Groups = groups
+		for _, group := range groups {
+			data.Rules = append(data.Rules, group.Rules...)
+		}
+		content, err := c.render(data)
+		if err != nil {
+			return nil, err
+		}
+		return singleFileOutput(c.output, content), nil
+	}
+
+	output := &Output{Owned: []string{c.ownedPattern()}}
+	seen := make(map[string]bool)
+	for _, group := range groups {
+		activation, globs := groupActivation(group.Rules)
+		data := base
+		data.Group = group
+		data.Rules = group.Rules
+		data.Activation = string(activation)
+		data.Globs = globs
+		data.Description = groupDescription(group)
+
+		var filePath bytes.Buffer
+		if err := c.pathPattern.Execute(&filePath, data); err != nil {
+			return nil, fmt.Errorf("渲染 path 失败: %w", err)
+		}
+		outputPath := path.Clean(strings.TrimSpace(filePath.String()))
+		if err := checkPluginPath(outputPath); err != nil {
+			return nil, fmt.Errorf("path 渲染出的路径无效: %w", err)
+		}
+		if seen[outputPath] {
+			return nil, fmt.Errorf("path 渲染出重复的文件路径 %s，请在路径中使用 .Group.Name", outputPath)
+		}
+		seen[outputPath] = true
+
+		content, err := c.render(data)
+		if err != nil {
+			return nil, err
+		}
+		output.Files = append(output.Files, OutputFile{Path: outputPath, Content: content})
+	}
+
+	if len(output.Files) == 0 {
+		return nil, fmt.Errorf("没有可输出的规则")
+	}
+	return output, nil
+}
+
+// ownedPattern 将 path 中的模板动作替换为 *，得到按分组输出的文件的 glob 模式
+func (c *CustomAdapter) ownedPattern() string {
+	return templateActionPattern.ReplaceAllString(c.rawPath, "*")
+}
+
+// render 渲染 front matter 和正文
+func (c *CustomAdapter) render(data customTemplateData) ([]byte, error) {
+	var content bytes.Buffer
+
+	var fields []string
+	for _, field := range c.frontMatter {
+		var value bytes.Buffer
+		if err := field.value.Execute(&value, data); err != nil {
+			return nil, fmt.Errorf("渲染 front_matter.%s 失败: %w", field.key, err)
+		}
+		if rendered := strings.TrimSpace(value.String()); rendered != "" {
+			fields = append(fields, fmt.Sprintf("%s: %s", field.key, rendered))
+		}
+	}
+	if len(fields) > 0 {
+		content.WriteString("---\n")
+		content.WriteString(strings.Join(fields, "\n"))
+		content.WriteString("\n---\n\n")
+	}
+
+	if err := c.body.Execute(&content, data); err != nil {
+		return nil, fmt.Errorf("渲染 template 失败: %w", err)
+	}
+
+	return []byte(strings.TrimRight(content.String(), "\n") + "\n"), nil
+}
@@ -0,0 +1,119 @@
+package platform
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadCustomPlatform(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		content  string
+		wantName string
+		wantErr  bool
+	}{
+		{
+			name:     "按分组输出到专用目录",
+			fileName: "acme.yaml",
+			content:  "path: .acme/rules/{{ .Group.Name }}.md\ntemplate: \"{{ .Notice }}\"\n",
+			wantName: "acme",
+		},
+		{
+			name:     "单文件输出到项目根目录",
+			fileName: "acme.yaml",
+			content:  "name: Acme-Root\noutput: ACME.md\ntemplate: \"{{ .Notice }}\"\n",
+			wantName: "acme-root",
+		},
+		{
+			name:     "按分组输出到项目根目录",
+			fileName: "acme.yaml",
+			content:  "path: \"{{ .Group.Name }}.md\"\ntemplate: \"{{ .Notice }}\"\n",
+			wantErr:  true,
+		},
+		{
+			name:     "目录部分包含模板动作",
+			fileName: "acme.yaml",
+			content:  "path: \"{{ .Group.Name }}/rules.md\"\ntemplate: \"{{ .Notice }}\"\n",
+			wantErr:  true,
+		},
+		{
+			name:     "路径超出项目目录",
+			fileName: "acme.yaml",
+			content:  "path: ../rules/{{ .Group.Name }}.md\ntemplate: \"{{ .Notice }}\"\n",
+			wantErr:  true,
+		},
+		{
+			name:     "文件名为保留名称",
+			fileName: "all.yaml",
+			content:  "output: ALL.md\ntemplate: \"{{ .Notice }}\"\n",
+			wantErr:  true,
+		},
+		{
+			name:     "name 为保留名称",
+			fileName: "acme.yaml",
+			content:  "name: ALL\noutput: ALL.md\ntemplate: \"{{ .Notice }}\"\n",
+			wantErr:  true,
+		},
+		{
+			name:     "同时声明 output 和 path",
+			fileName: "acme.yaml",
+			content:  "output: ACME.md\npath: .acme/{{ .Group.Name }}.md\ntemplate: \"{{ .Notice }}\"\n",
+			wantErr:  true,
+		},
+		{
+			name:     "缺少 template",
+			fileName: "acme.yaml",
+			content:  "output: ACME.md\n",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), tt.fileName)
+			if err := os.WriteFile(filePath, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			adapter, err := loadCustomPlatform(filePath)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("期望返回错误，实际为 nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("意外的错误: %v", err)
+			}
+			if adapter.Name() != tt.wantName {
+				t.Errorf("Name() = %q，期望 %q", adapter.Name(), tt.wantName)
+			}
+		})
+	}
+}
+
+func TestCustomAdapterConvert(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "acme.yaml")
+	content := "path: .acme/rules/{{ .Group.Name }}.md\nfront_matter:\n  globs: \"{{ join .Globs \\\",\\\" }}\"\ntemplate: |\n  {{ .Notice }}\n  {{ range .Rules }}{{ .Content }}{{ end }}\n"
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	adapter, err := loadCustomPlatform(filePath)
+	if err != nil {
+		t.Fatalf("意外的错误: %v", err)
+	}
+
+	output := convertWith(t, adapter, nil, testRuleSet())
+	want := []string{".acme/rules/security.md", ".acme/rules/api-handlers.md", ".acme/rules/naming.md"}
+	if got := filePaths(output); !equalStrings(got, want) {
+		t.Errorf("输出文件 = %q，期望 %q", got, want)
+	}
+	if got := fileContent(t, output, ".acme/rules/api-handlers.md"); !containsAll(got, "---\nglobs: services/api/**/*.go\n---\n", GeneratedMarker, "Return JSON errors.") {
+		t.Errorf(".acme/rules/api-handlers.md 的内容不符合预期:\n%s", got)
+	}
+	if !equalStrings(output.Owned, []string{".acme/rules/*.md"}) {
+		t.Errorf("Owned = %q，期望 [\".acme/rules/*.md\"]", output.Owned)
+	}
+}
@@ -0,0 +1,68 @@
+package platform
+
+import (
+	"path"
+	"strings"
+)
+
+// ruleDirectory 目录型平台的输出布局：每个规则分组写入目录下的一个 Markdown 文件
+// 新增目录型编辑器时只需提供目录、文件名和 front matter 的生成方式
+type ruleDirectory struct {
+	// 规则文件所在目录
+	Dir string
+
+	// 平台名称，用于生成说明
+	Platform string
+
+	// 生成说明和作用范围注释使用的消息目录
+	Messages *messages
+
+	// 返回分组的文件名（不含扩展名），为 nil 时使用分组名称
+	FileName func(group RuleGroup) string
+
+	// 返回分组的 front matter（含 --- 分隔行），为 nil 时不写入 front matter
+	FrontMatter func(group RuleGroup, activation Activation, globs []string) string
+
+	// 平台无法通过 front matter 限定作用范围时，在正文中注明适用的文件
+	AnnotateScope bool
+}
+
+// Files 为每个分组生成一个规则文件
+func (d ruleDirectory) Files(groups []RuleGroup) []OutputFile {
+	files := make([]OutputFile, 0, len(groups))
+	for _, group := range groups {
+		var content strings.Builder
+		if d.FrontMatter != nil {
+			activation, globs := groupActivation(group.Rules)
+			content.WriteString(d.FrontMatter(group, activation, globs))
+		}
+		content.WriteString(generatedNotice(d.Messages, d.Platform))
+		content.WriteString("\n")
+
+		switch {
+		case len(group.Rules) == 1 && d.AnnotateScope:
+			writeScopedRuleMarkdown(&content, d.Messages, group.Rules[0], 1)
+		case len(group.Rules) == 1:
+			writeRuleMarkdown(&content, group.Rules[0], 1)
+		case d.AnnotateScope:
+			writeScopedGroupMarkdown(&content, d.Messages, group, 1)
+		default:
+			writeGroupMarkdown(&content, group, 1)
+		}
+
+		name := group.Name
+		if d.FileName != nil {
+			name = d.FileName(group)
+		}
+		files = append(files, OutputFile{
+			Path:    path.Join(d.Dir, name+".md"),
+			Content: markdownBytes(&content),
+		})
+	}
+	return files
+}
+
+// Owned 返回目录下规则文件的 glob 模式
+func (d ruleDirectory) Owned() string {
+	return path.Join(d.Dir, "*.md")
+}
@@ -0,0 +1,187 @@
+package platform
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+const (
+	// geminiSettingsPath Gemini CLI 项目级配置文件
+	geminiSettingsPath = ".gemini/settings.json"
+
+	// geminiContextKey 配置文件中指定上下文文件名的字段
+	geminiContextKey = "contextFileName"
+
+	// geminiSplitChars 分组超过该字符数时拆分为独立文件并通过 @file.md 导入
+	geminiSplitChars = 4000
+)
+
+// GeminiAdapter Gemini CLI平台适配器
+// 规则写入项目根目录的 GEMINI.md；较大的规则分组写入 .gemini/rules/ 下的独立文件，
+// 在 GEMINI.md 中通过 Gemini 的 @file.md 语法导入。
+// 开启 update_settings 后会将 GEMINI.md 合并到 .gemini/settings.json 的 contextFileName 中
+type GeminiAdapter struct {
+	groupBy        string
+	rulesDir       string
+	splitChars     int
+	updateSettings bool
+
+	// 被覆盖后的 GEMINI.md 输出路径，为空时使用默认路径
+	outputPath string
+}
+
+// NewGeminiAdapter 创建新的Gemini CLI适配器
+func NewGeminiAdapter() *GeminiAdapter {
+	return &GeminiAdapter{
+		groupBy:    GroupBySource,
+		rulesDir:   ".gemini/rules",
+		splitChars: geminiSplitChars,
+	}
+}
+
+// Name 返回平台名称
+func (g *GeminiAdapter) Name() string {
+	return "gemini"
+}
+
+// DefaultOutputPath 返回GEMINI.md路径
+func (g *GeminiAdapter) DefaultOutputPath() string {
+	return "GEMINI.md"
+}
+
+// SetOutputPath 设置 GEMINI.md 的实际输出路径，用于导入语句和 contextFileName
+func (g *GeminiAdapter) SetOutputPath(path string) {
+	g.outputPath = path
+}
+
+// Configure 应用平台选项
+//   - group_by: 分组方式 source、type 或 rule（默认 source）
+//   - rules_dir: 拆分文件所在目录（默认 .gemini/rules）
+//   - split_chars: 分组超过该字符数时拆分为导入文件（默认 4000）
+//   - update_settings: 是否更新 .gemini/settings.json 的 contextFileName（默认 false）
+func (g *GeminiAdapter) Configure(options map[string]string) error {
+	if err := checkOptions(g.Name(), options, "group_by", "rules_dir", "split_chars", "update_settings"); err != nil {
+		return err
+	}
+
+	groupBy, err := enumOption(options, "group_by", g.groupBy, GroupBySource, GroupByType, GroupByRule)
+	if err != nil {
+		return err
+	}
+
+	updateSettings, err := boolOption(options, "update_settings", g.updateSettings)
+	if err != nil {
+		return err
+	}
+
+	if value := options["split_chars"]; value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			return fmt.Errorf("选项 \"split_chars\" 的值 \"%s\" 不是有效的正整数", value)
+		}
+		g.splitChars = parsed
+	}
+
+	g.groupBy = groupBy
+	g.updateSettings = updateSettings
+	if dir := strings.TrimSpace(options["rules_dir"]); dir != "" {
+		g.rulesDir = strings.TrimSuffix(dir, "/")
+	}
+
+	return nil
+}
+
+// Convert 将统一规则转换为Gemini CLI格式，返回 GEMINI.md、导入文件及合并后的配置文件
+func (g *GeminiAdapter) Convert(ruleSet *rules.RuleSet) (*Output, error) {
+	ruleSet = withDefaultLanguage(ruleSet, rules.LangEN)
+	msg := messagesFor(ruleSet, rules.LangEN)
+
+	groups, err := GroupRules(ruleSet, g.groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	var content strings.Builder
+	content.WriteString("# " + msg.text("title.project_rules", ruleSet.Metadata.ProjectName) + "\n\n")
+	content.WriteString(generatedNotice(msg, g.Name()))
+	content.WriteString("\n")
+	writeProjectInfo(&content, msg, ruleSet.Metadata)
+	content.WriteString(msg.text("priority_order") + "\n\n")
+
+	mainPath := resolveOutputPath(g.outputPath, g.DefaultOutputPath())
+	output := &Output{
+		Files: []OutputFile{{Path: g.DefaultOutputPath()}},
+		Owned: []string{path.Join(g.rulesDir, "*.md")},
+	}
+
+	for _, group := range groups {
+		var groupContent strings.Builder
+		writeGroupMarkdown(&groupContent, group, 2)
+		if utf8.RuneCountInString(groupContent.String()) <= g.splitChars {
+			content.WriteString(groupContent.String())
+			continue
+		}
+
+		// 较大的分组写入独立文件，GEMINI.md 中只保留导入语句
+		rulePath := path.Join(g.rulesDir, group.Name+".md")
+		content.WriteString(fmt.Sprintf("## %s\n\n@%s\n\n", group.Title, importPath(mainPath, rulePath)))
+
+		var imported strings.Builder
+		imported.WriteString(generatedNotice(msg, g.Name()))
+		imported.WriteString("\n")
+		writeGroupMarkdown(&imported, group, 1)
+		output.Files = append(output.Files, OutputFile{Path: rulePath, Content: markdownBytes(&imported)})
+	}
+	output.Files[0].Content = markdownBytes(&content)
+
+	if g.updateSettings {
+		settings, err := g.mergeSettings(mainPath)
+		if err != nil {
+			return nil, err
+		}
+		if settings != nil {
+			output.Files = append(output.Files, *settings)
+		}
+	}
+
+	return output, nil
+}
+
+// mergeSettings 将 GEMINI.md 的实际输出路径合并到 .gemini/settings.json 的 contextFileName 中
+// 保留其他配置项、顺序和缩进；contextFileName 已包含该路径时返回 nil，不修改文件
+func (g *GeminiAdapter) mergeSettings(fileName string) (*OutputFile, error) {
+	data, mode, err := readExistingFile(geminiSettingsPath)
+	if err != nil {
+		return nil, fmt.Errorf("读取 %s 失败: %w", geminiSettingsPath, err)
+	}
+
+	content, changed, err := mergeJSONListItem(data, geminiContextKey, fileName)
+	if err != nil {
+		return nil, fmt.Errorf("合并 %s 的 %s 失败（不支持注释等非标准 JSON，请手动设置）: %w",
+			geminiSettingsPath, geminiContextKey, err)
+	}
+	if !changed {
+		return nil, nil
+	}
+
+	return &OutputFile{Path: geminiSettingsPath, Content: content, Mode: mode, Merged: true}, nil
+}
+
+// importPath 返回 Gemini @file.md 导入语句中的路径，导入路径相对于导入它的文件所在目录
+func importPath(fromFile, target string) string {
+	dir := path.Dir(fromFile)
+	if dir == "." {
+		return target
+	}
+	relative, err := filepath.Rel(filepath.FromSlash(dir), filepath.FromSlash(target))
+	if err != nil {
+		return target
+	}
+	return filepath.ToSlash(relative)
+}
@@ -0,0 +1,49 @@
+package platform
+
+import "testing"
+
+func TestGeminiAdapterConvert(t *testing.T) {
+	runAdapterCases(t, func() PlatformAdapter { return NewGeminiAdapter() }, []adapterCase{
+		{
+			name:  "单文件",
+			paths: []string{"GEMINI.md"},
+			contains: map[string][]string{
+				"GEMINI.md": {GeneratedMarker, "Never hardcode secrets.", "Use camelCase."},
+			},
+			excludes: map[string][]string{"GEMINI.md": {"@.gemini/rules/", "Never shown."}},
+		},
+		{
+			name:    "较大的分组拆分为导入文件",
+			options: map[string]string{"split_chars": "100"},
+			paths:   []string{"GEMINI.md", ".gemini/rules/project.md"},
+			contains: map[string][]string{
+				"GEMINI.md":                {"@.gemini/rules/project.md", "Use camelCase."},
+				".gemini/rules/project.md": {GeneratedMarker, "Never hardcode secrets.", "Return JSON errors."},
+			},
+			excludes: map[string][]string{"GEMINI.md": {"Never hardcode secrets."}},
+		},
+		{
+			name:    "按规则分组并写入 settings.json",
+			options: map[string]string{"group_by": "rule", "split_chars": "1", "rules_dir": "docs/gemini", "update_settings": "true"},
+			paths:   []string{"GEMINI.md", "docs/gemini/security.md", "docs/gemini/api-handlers.md", "docs/gemini/naming.md", ".gemini/settings.json"},
+			contains: map[string][]string{
+				"GEMINI.md":             {"@docs/gemini/security.md", "@docs/gemini/api-handlers.md", "@docs/gemini/naming.md"},
+				".gemini/settings.json": {"\"contextFileName\": \"GEMINI.md\""},
+			},
+		},
+	})
+}
+
+func TestGeminiAdapterOutputPath(t *testing.T) {
+	adapter := NewGeminiAdapter()
+	adapter.SetOutputPath("docs/GEMINI.md")
+	output := convertWith(t, adapter, map[string]string{"split_chars": "1", "update_settings": "true"}, testRuleSet())
+
+	// 导入路径相对于 GEMINI.md 所在目录，contextFileName 使用实际输出路径
+	if content := fileContent(t, output, "GEMINI.md"); !containsAll(content, "@../.gemini/rules/project.md", "@../.gemini/rules/global.md") {
+		t.Errorf("GEMINI.md 的导入路径不正确：\n%s", content)
+	}
+	if content := fileContent(t, output, ".gemini/settings.json"); !containsAll(content, "\"contextFileName\": \"docs/GEMINI.md\"") {
+		t.Errorf("settings.json 未使用实际输出路径：\n%s", content)
+	}
+}
@@ -0,0 +1,19 @@
+package platform
+
+// GooseAdapter Goose平台适配器
+// Goose 读取项目根目录的 .goosehints 作为提示，使用省略规则描述的紧凑格式
+type GooseAdapter struct {
+	singleFileAdapter
+}
+
+// NewGooseAdapter 创建新的Goose适配器
+func NewGooseAdapter() *GooseAdapter {
+	return &GooseAdapter{singleFileAdapter{
+		name:       "goose",
+		outputPath: ".goosehints",
+		titleKey:   "title.hints",
+		introKey:   "goose.intro",
+		compact:    true,
+		maxChars:   singleFileMaxChars,
+	}}
+}
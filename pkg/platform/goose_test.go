@@ -0,0 +1,16 @@
+package platform
+
+import "testing"
+
+func TestGooseAdapterConvert(t *testing.T) {
+	runAdapterCases(t, func() PlatformAdapter { return NewGooseAdapter() }, []adapterCase{
+		{
+			name:  "紧凑格式省略规则描述",
+			paths: []string{".goosehints"},
+			contains: map[string][]string{".goosehints": {
+				"# demo Hints", "Follow these project rules", "Never hardcode secrets.", "Use camelCase.",
+			}},
+			excludes: map[string][]string{".goosehints": {"HTTP handler conventions", "Never shown."}},
+		},
+	})
+}
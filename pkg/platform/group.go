@@ -0,0 +1,205 @@
+package platform
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+// 规则分组方式
+const (
+	// GroupBySource 按规则来源分组（project、global、templates）
+	GroupBySource = "source"
+
+	// GroupByType 按规则类型分组（security、code_style 等）
+	GroupByType = "type"
+
+	// GroupByRule 每条规则单独一组
+	GroupByRule = "rule"
+)
+
+// projectFileName 目录型平台中项目信息文件的文件名（不含扩展名），分组名不会与之相同
+const projectFileName = "00-project"
+
+// RuleGroup 输出到同一文件的一组规则
+type RuleGroup struct {
+	// 组名，可直接用作文件名（不含扩展名）
+	Name string
+
+	// 组标题
+	Title string
+
+	// 组内已启用的规则
+	Rules []rules.Rule
+}
+
+// GroupRules 按指定方式对已启用的规则分组
+// 分组顺序遵循 rule_priority，组内保持规则的原始顺序；同名分组会追加序号以保证文件名唯一。
+// 分组名不会与 projectFileName 及 reserved 中适配器自身使用的固定文件名相同。
+// 分组标题使用规则集的输出语言，未指定时为英文
+func GroupRules(ruleSet *rules.RuleSet, by string, reserved ...string) ([]RuleGroup, error) {
+	msg := messagesFor(ruleSet, rules.LangEN)
+	var groups []RuleGroup
+	index := make(map[string]int)
+	used := map[string]bool{projectFileName: true}
+	for _, name := range reserved {
+		used[name] = true
+	}
+
+	// key 用于判断规则是否属于同一组，base 用于生成文件名
+	add := func(key, base, title string, rule rules.Rule) {
+		if i, exists := index[key]; exists {
+			groups[i].Rules = append(groups[i].Rules, rule)
+			return
+		}
+
+		name := uniqueName(slugify(base), used)
+		index[key] = len(groups)
+		groups = append(groups, RuleGroup{Name: name, Title: title, Rules: []rules.Rule{rule}})
+	}
+
+	for _, section := range ruleSet.Sections() {
+		for i, rule := range section.Rules {
+			if !rule.Enabled {
+				continue
+			}
+
+			switch by {
+			case GroupBySource:
+				add(section.Source, section.Source, msg.sourceTitle(section.Source), rule)
+			case GroupByType:
+				ruleType := rule.Type
+				if ruleType == "" {
+					ruleType = "general"
+				}
+				add(ruleType, ruleType, msg.typeTitle(ruleType), rule)
+			case GroupByRule:
+				add(fmt.Sprintf("%s/%d", section.Source, i), rule.Title, rule.Title, rule)
+			default:
+				return nil, fmt.Errorf("不支持的分组方式 \"%s\"，可选值：%s, %s, %s",
+					by, GroupBySource, GroupByType, GroupByRule)
+			}
+		}
+	}
+
+	return groups, nil
+}
+
+// filterRuleSet 返回只包含满足 keep 条件的规则的规则集副本
+// 对副本分组可以保证生成的文件名只与参与输出的规则有关
+func filterRuleSet(ruleSet *rules.RuleSet, keep func(rules.Rule) bool) *rules.RuleSet {
+	filter := func(ruleList []rules.Rule) []rules.Rule {
+		var result []rules.Rule
+		for _, rule := range ruleList {
+			if keep(rule) {
+				result = append(result, rule)
+			}
+		}
+		return result
+	}
+
+	filtered := *ruleSet
+	filtered.ProjectRules = filter(ruleSet.ProjectRules)
+	filtered.GlobalRules = filter(ruleSet.GlobalRules)
+	filtered.TemplateRules = filter(ruleSet.TemplateRules)
+	return &filtered
+}
+
+// isScoped 判断规则是否限定了作用范围
+func isScoped(rule rules.Rule) bool {
+	return len(rule.Globs) > 0
+}
+
+// isUnscoped 判断规则是否适用于整个项目
+func isUnscoped(rule rules.Rule) bool {
+	return len(rule.Globs) == 0
+}
+
+// typeTitle 将规则类型转换为标题，如 code_style 转换为 Code Style
+func typeTitle(ruleType string) string {
+	words := strings.Fields(strings.ReplaceAll(ruleType, "_", " "))
+	for i, word := range words {
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		words[i] = string(runes)
+	}
+	return strings.Join(words, " ")
+}
+
+// slugify 将标题转换为适合作为文件名的形式
+// 保留字母（含中文）和数字，其他字符替换为连字符
+func slugify(title string) string {
+	var slug strings.Builder
+	lastDash := true
+	for _, r := range strings.ToLower(title) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			slug.WriteRune(r)
+			lastDash = false
+			continue
+		}
+		if !lastDash {
+			slug.WriteRune('-')
+			lastDash = true
+		}
+	}
+
+	result := strings.Trim(slug.String(), "-")
+	if result == "" {
+		return "rules"
+	}
+	return result
+}
+
+// uniqueName 返回未被使用过的名称，冲突时追加序号
+func uniqueName(name string, used map[string]bool) string {
+	candidate := name
+	for i := 2; used[candidate]; i++ {
+		candidate = fmt.Sprintf("%s-%d", name, i)
+	}
+	used[candidate] = true
+	return candidate
+}
+
+// priorityFileName 返回以优先级为前缀的文件名（不含扩展名），如优先级 5 的规则为 10-安全约束
+// 前缀只取决于组内最高优先级，新增或删除其他规则不会导致文件重命名；
+// 按文件名排序即按优先级从高到低排列
+func priorityFileName(group RuleGroup) string {
+	priority := 0
+	for _, rule := range group.Rules {
+		if rule.Priority > priority {
+			priority = rule.Priority
+		}
+	}
+
+	// 优先级限定在 1-5 之间，未设置时视为 1
+	if priority < 1 {
+		priority = 1
+	}
+	if priority > 5 {
+		priority = 5
+	}
+
+	return fmt.Sprintf("%d0-%s", 6-priority, group.Name)
+}
+
+// sourceRuleSet 返回只包含指定来源规则的规则集副本
+func sourceRuleSet(ruleSet *rules.RuleSet, sources ...string) *rules.RuleSet {
+	keep := make(map[string]bool, len(sources))
+	for _, source := range sources {
+		keep[source] = true
+	}
+
+	filtered := *ruleSet
+	if !keep[rules.SourceProject] {
+		filtered.ProjectRules = nil
+	}
+	if !keep[rules.SourceGlobal] {
+		filtered.GlobalRules = nil
+	}
+	if !keep[rules.SourceTemplates] {
+		filtered.TemplateRules = nil
+	}
+	return &filtered
+}
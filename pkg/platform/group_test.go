@@ -0,0 +1,51 @@
+package platform
+
+import (
+	"testing"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+func TestGroupRulesNames(t *testing.T) {
+	ruleSet := &rules.RuleSet{
+		ProjectRules: []rules.Rule{
+			{Title: "00 Project", Enabled: true},
+			{Title: "Code Style", Enabled: true},
+			{Title: "code style", Enabled: true},
+			{Title: "Product", Enabled: true},
+			{Title: "!!!", Enabled: true},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		reserved []string
+		want     []string
+	}{
+		{
+			name: "不与项目信息文件和其他分组重名",
+			want: []string{"00-project-2", "code-style", "code-style-2", "product", "rules"},
+		},
+		{
+			name:     "不与适配器的固定文件名重名",
+			reserved: []string{"product"},
+			want:     []string{"00-project-2", "code-style", "code-style-2", "product-2", "rules"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups, err := GroupRules(ruleSet, GroupByRule, tt.reserved...)
+			if err != nil {
+				t.Fatalf("意外的错误: %v", err)
+			}
+			names := make([]string, 0, len(groups))
+			for _, group := range groups {
+				names = append(names, group.Name)
+			}
+			if !equalStrings(names, tt.want) {
+				t.Errorf("分组名 = %q，期望 %q", names, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package platform
+
+import (
+	"fmt"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+// catalogs 各输出语言的消息目录，键为消息 ID，值为 fmt 格式字符串
+// 新增语言时需要提供与 en 相同的全部键
+var catalogs = map[string]map[string]string{
+	rules.LangEN: {
+		// 通用
+		"notice":                   "<!-- %s. Edit the files in .ruler/ and re-run `pf_ruler generate --platform=%s`. -->",
+		"project_info":             "Project Information",
+		"label.project":            "Project",
+		"label.project_name":       "Project Name",
+		"label.tech_stack":         "Tech Stack",
+		"label.ai_editors":         "Target AI Editors",
+		"label.generated_at":       "Generated At",
+		"label.version":            "Version",
+		"label.type":               "Type",
+		"label.priority":           "Priority",
+		"label.tags":               "Tags",
+		"label.description":        "Description",
+		"label.rule":               "Rule",
+		"applies_to":               "%s (applies to `%s`)",
+		"applies_to_plain":         "%s (applies to %s)",
+		"priority_order":           "Rules are listed in priority order; earlier rules take precedence when they conflict.",
+		"numbered_order":           "Rule files are numbered by priority; lower numbers take precedence when rules conflict.",
+		"generated_from":           "Rules in %s are generated from the .ruler directory. ",
+		"source_precedence":        "Rules from earlier sources take precedence when rules conflict: %s.",
+		"priority_note":            "Rules listed earlier take precedence when rules conflict.",
+		"project_rule_description": "Project information and rule priority",
+		"heading.rules":            "Rules",
+		"heading.rule_priority":    "Rule Priority",
+		"heading.usage":            "Usage Instructions",
+		"heading.updating_rules":   "Updating Rules",
+		"source.project":           "Project-Specific Rules",
+		"source.global":            "Global Rules",
+		"source.templates":         "Custom Template Rules",
+
+		// 各平台文件标题及说明
+		"title.project_rules":          "%s Project Rules",
+		"title.agent_guidelines":       "%s Agent Guidelines",
+		"title.coding_conventions":     "%s Coding Conventions",
+		"title.copilot_instructions":   "%s Copilot Instructions",
+		"title.development_guidelines": "%s Development Guidelines",
+		"title.repository_rules":       "%s Repository Rules",
+		"title.windsurf_rules":         "%s Windsurf Rules",
+		"title.rules":                  "%s Rules",
+		"title.hints":                  "%s Hints",
+		"title.warp":                   "WARP.md - %s",
+		"title.project":                "%s Project",
+		"agents.nested_intro":          "These rules apply to files under `%s/` and extend the project-wide rules in the root AGENTS.md.",
+		"goose.intro":                  "Follow these project rules when working in this repository.",
+		"warp.intro":                   "This file provides guidance to Warp (warp.dev) when working with code in this repository.",
+		"kiro.product":                 "Product Overview",
+		"kiro.tech":                    "Technology Stack",
+		"kiro.structure":               "Project Structure",
+		"openhands.knowledge_title":    "Rules for %s",
+
+		// Trae
+		"trae.title":             "%s Project Rule Set",
+		"trae.rule_files":        "Rule Files",
+		"trae.rule_files_intro":  "Rules are split into the following files; earlier files take precedence:",
+		"trae.usage_intro":       "This rule set is automatically generated by pf_ruler to guide the AI editor to generate code that follows project standards.",
+		"trae.update_hint":       "To update rules, modify the corresponding files in the `.ruler` directory, then re-run `pf_ruler generate --platform=%s`.",
+		"trae.user_rules_export": "Global and template rules are exported to the user rules file `%s`; use it in Trae's personal rules.",
+		"trae.user_rules_title":  "User Rules",
+		"trae.user_rules_intro":  "These rules apply to all projects and can be copied into the personal rules (user_rules.md) in Trae settings.",
+		"trae.note.project":      "These rules apply to the current project",
+		"trae.note.global":       "These rules apply to all projects",
+		"trae.note.templates":    "These rules come from user-defined templates",
+
+		// Cursor
+		"cursor.title":          "Cursor Rules for %s",
+		"cursor.generated":      "%s on %s",
+		"cursor.usage_intro":    "This rules file is automatically generated by pf_ruler tool.\nIt guides the AI editor to generate code that follows project standards.",
+		"cursor.update_hint":    "To update rules, modify the corresponding files in the .ruler directory,\nthen re-run: pf_ruler generate --platform=%s",
+		"cursor.note.project":   "apply to the current project",
+		"cursor.note.global":    "apply to all projects",
+		"cursor.note.templates": "from user configuration",
+	},
+
+	rules.LangZhCN: {
+		// 通用
+		"notice":                   "<!-- %s。请修改 .ruler/ 中的文件后重新运行 `pf_ruler generate --platform=%s`。 -->",
+		"project_info":             "项目信息",
+		"label.project":            "项目",
+		"label.project_name":       "项目名称",
+		"label.tech_stack":         "技术栈",
+		"label.ai_editors":         "目标AI编辑器",
+		"label.generated_at":       "生成时间",
+		"label.version":            "版本",
+		"label.type":               "类型",
+		"label.priority":           "优先级",
+		"label.tags":               "标签",
+		"label.description":        "描述",
+		"label.rule":               "规则内容",
+		"applies_to":               "%s（适用于 `%s`）",
+		"applies_to_plain":         "%s（适用于 %s）",
+		"priority_order":           "规则按优先级排列，规则冲突时以靠前的规则为准。",
+		"numbered_order":           "规则文件按优先级编号，规则冲突时以编号较小的文件为准。",
+		"generated_from":           "%s 中的规则由 .ruler 目录生成。",
+		"source_precedence":        "规则冲突时以靠前来源的规则为准：%s。",
+		"priority_note":            "排在前面的规则优先级更高，规则冲突时以靠前的规则为准。",
+		"project_rule_description": "项目信息和规则优先级",
+		"heading.rules":            "规则",
+		"heading.rule_priority":    "规则优先级",
+		"heading.usage":            "使用说明",
+		"heading.updating_rules":   "更新规则",
+		"source.project":           "项目特定规则",
+		"source.global":            "全局通用规则",
+		"source.templates":         "自定义模板规则",
+
+		// 各平台文件标题及说明
+		"title.project_rules":          "%s 项目规则",
+		"title.agent_guidelines":       "%s 智能体指南",
+		"title.coding_conventions":     "%s 编码约定",
+		"title.copilot_instructions":   "%s Copilot 指令",
+		"title.development_guidelines": "%s 开发指南",
+		"title.repository_rules":       "%s 仓库规则",
+		"title.windsurf_rules":         "%s Windsurf 规则",
+		"title.rules":                  "%s 规则",
+		"title.hints":                  "%s 提示",
+		"title.warp":                   "WARP.md - %s",
+		"title.project":                "%s 项目",
+		"agents.nested_intro":          "这些规则适用于 `%s/` 下的文件，是对根目录 AGENTS.md 中项目级规则的补充。",
+		"goose.intro":                  "在本仓库中工作时请遵循以下项目规则。",
+		"warp.intro":                   "本文件为 Warp (warp.dev) 在本仓库中处理代码时提供指导。",
+		"kiro.product":                 "产品概述",
+		"kiro.tech":                    "技术栈",
+		"kiro.structure":               "项目结构",
+		"openhands.knowledge_title":    "%s 相关规则",
+
+		// Trae
+		"trae.title":             "%s 项目规则集",
+		"trae.rule_files":        "规则文件",
+		"trae.rule_files_intro":  "规则按分组拆分为以下文件，排在前面的文件优先级更高：",
+		"trae.usage_intro":       "本规则集由 pf_ruler 工具自动生成，用于指导 AI 编辑器生成符合项目规范的代码。",
+		"trae.update_hint":       "如需更新规则，请修改 `.ruler` 目录下的相应文件，然后重新运行 `pf_ruler generate --platform=%s` 命令。",
+		"trae.user_rules_export": "全局规则和模板规则已导出为用户规则文件 `%s`，请在 Trae 的个人规则中使用。",
+		"trae.user_rules_title":  "用户规则",
+		"trae.user_rules_intro":  "这些规则适用于所有项目，可复制到 Trae 设置的个人规则（user_rules.md）中。",
+		"trae.note.project":      "这些规则适用于当前项目",
+		"trae.note.global":       "这些规则适用于所有项目",
+		"trae.note.templates":    "这些规则来自用户自定义模板",
+
+		// Cursor
+		"cursor.title":          "%s 的 Cursor 规则",
+		"cursor.generated":      "%s，生成时间 %s",
+		"cursor.usage_intro":    "本规则文件由 pf_ruler 工具自动生成，\n用于指导 AI 编辑器生成符合项目规范的代码。",
+		"cursor.update_hint":    "如需更新规则，请修改 .ruler 目录下的相应文件，\n然后重新运行：pf_ruler generate --platform=%s",
+		"cursor.note.project":   "适用于当前项目",
+		"cursor.note.global":    "适用于所有项目",
+		"cursor.note.templates": "来自用户配置",
+
+		// 规则类型标题，未列出的类型使用英文标题
+		"type.cache":          "缓存",
+		"type.code_style":     "代码风格",
+		"type.database":       "数据库",
+		"type.deployment":     "部署",
+		"type.documentation":  "文档",
+		"type.error_handling": "错误处理",
+		"type.framework":      "框架",
+		"type.general":        "通用",
+		"type.naming":         "命名",
+		"type.performance":    "性能",
+		"type.security":       "安全",
+		"type.tech_stack":     "技术栈",
+		"type.testing":        "测试",
+		"type.structure":      "项目结构",
+		"type.architecture":   "架构",
+	},
+}
+
+// messages 某种输出语言的消息目录
+type messages struct {
+	lang    string
+	catalog map[string]string
+}
+
+// messagesFor 返回规则集输出语言的消息目录，未指定输出语言时使用平台的默认语言
+func messagesFor(ruleSet *rules.RuleSet, defaultLang string) *messages {
+	lang := ruleSet.Language
+	if _, ok := catalogs[lang]; !ok {
+		lang = defaultLang
+	}
+	return &messages{lang: lang, catalog: catalogs[lang]}
+}
+
+// withDefaultLanguage 返回按平台默认语言翻译的规则集，规则集已指定输出语言时原样返回
+// 适配器在转换开始时调用，使规则正文的翻译与标题、标签等说明文字的语言一致，分组标题等共用逻辑也使用同一语言
+func withDefaultLanguage(ruleSet *rules.RuleSet, lang string) *rules.RuleSet {
+	if ruleSet.Language != "" {
+		return ruleSet
+	}
+	return ruleSet.Localize(lang)
+}
+
+// text 返回格式化后的消息，目录中缺少该消息时依次回退到英文目录和消息 ID
+func (m *messages) text(key string, args ...interface{}) string {
+	format, ok := m.catalog[key]
+	if !ok {
+		if format, ok = catalogs[rules.LangEN][key]; !ok {
+			format = key
+		}
+	}
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// sourceTitle 返回规则来源的标题
+func (m *messages) sourceTitle(source string) string {
+	return m.text("source." + source)
+}
+
+// typeTitle 返回规则类型的标题，目录中没有对应翻译时由类型名称生成，如 code_style 转换为 Code Style
+func (m *messages) typeTitle(ruleType string) string {
+	if title, ok := m.catalog["type."+ruleType]; ok {
+		return title
+	}
+	return typeTitle(ruleType)
+}
@@ -0,0 +1,78 @@
+package platform
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+// jetbrainsRulesDir JetBrains AI Assistant 项目规则目录
+const jetbrainsRulesDir = ".aiassistant/rules"
+
+// JetBrainsAdapter JetBrains AI Assistant平台适配器
+// 在 .aiassistant/rules/ 下为每条规则（或每个分组）生成 Markdown 文件。
+// AI Assistant 的规则类型（Always、By file patterns 等）保存在 IDE 设置中而不是文件里，
+// 因此带作用范围的规则会在正文中注明适用的文件
+type JetBrainsAdapter struct {
+	groupBy string
+}
+
+// NewJetBrainsAdapter 创建新的JetBrains AI Assistant适配器
+func NewJetBrainsAdapter() *JetBrainsAdapter {
+	return &JetBrainsAdapter{groupBy: GroupBySource}
+}
+
+// Name 返回平台名称
+func (j *JetBrainsAdapter) Name() string {
+	return "jetbrains"
+}
+
+// DefaultOutputPath 返回项目信息规则文件路径
+func (j *JetBrainsAdapter) DefaultOutputPath() string {
+	return path.Join(jetbrainsRulesDir, projectFileName+".md")
+}
+
+// Configure 应用平台选项
+//   - group_by: 分组方式 source、type 或 rule（默认 source）
+func (j *JetBrainsAdapter) Configure(options map[string]string) error {
+	if err := checkOptions(j.Name(), options, "group_by"); err != nil {
+		return err
+	}
+
+	groupBy, err := enumOption(options, "group_by", j.groupBy, GroupBySource, GroupByType, GroupByRule)
+	if err != nil {
+		return err
+	}
+
+	j.groupBy = groupBy
+	return nil
+}
+
+// Convert 将统一规则转换为JetBrains AI Assistant格式，返回项目信息文件和各分组的规则文件
+func (j *JetBrainsAdapter) Convert(ruleSet *rules.RuleSet) (*Output, error) {
+	ruleSet = withDefaultLanguage(ruleSet, rules.LangEN)
+	msg := messagesFor(ruleSet, rules.LangEN)
+
+	groups, err := GroupRules(ruleSet, j.groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	var project strings.Builder
+	project.WriteString(fmt.Sprintf("# %s\n\n", ruleSet.Metadata.ProjectName))
+	project.WriteString(generatedNotice(msg, j.Name()))
+	project.WriteString("\n")
+	writeProjectInfo(&project, msg, ruleSet.Metadata)
+
+	output := &Output{
+		Files: []OutputFile{{Path: j.DefaultOutputPath(), Content: markdownBytes(&project)}},
+		Owned: []string{path.Join(jetbrainsRulesDir, "*.md")},
+	}
+
+	directory := ruleDirectory{Dir: jetbrainsRulesDir, Platform: j.Name(), Messages: msg, AnnotateScope: true}
+	output.Files = append(output.Files, directory.Files(groups)...)
+
+	return output, nil
+}
@@ -0,0 +1,44 @@
+package platform
+
+import "testing"
+
+func TestJetBrainsAdapterConvert(t *testing.T) {
+	runAdapterCases(t, func() PlatformAdapter { return NewJetBrainsAdapter() }, []adapterCase{
+		{
+			name:  "按来源分组",
+			paths: []string{".aiassistant/rules/00-project.md", ".aiassistant/rules/project.md", ".aiassistant/rules/global.md"},
+			contains: map[string][]string{
+				".aiassistant/rules/00-project.md": {"# demo", GeneratedMarker},
+				".aiassistant/rules/project.md":    {GeneratedMarker, "Never hardcode secrets.", "services/api/**/*.go", "Return JSON errors."},
+				".aiassistant/rules/global.md":     {"Use camelCase."},
+			},
+			excludes: map[string][]string{".aiassistant/rules/project.md": {"---\n"}},
+		},
+		{
+			name:    "按规则分组并注明作用范围",
+			options: map[string]string{"group_by": "rule"},
+			paths: []string{
+				".aiassistant/rules/00-project.md",
+				".aiassistant/rules/security.md",
+				".aiassistant/rules/api-handlers.md",
+				".aiassistant/rules/naming.md",
+			},
+			contains: map[string][]string{
+				".aiassistant/rules/api-handlers.md": {"services/api/**/*.go", "Return JSON errors."},
+			},
+		},
+	})
+}
+
+func TestJunieAdapterConvert(t *testing.T) {
+	runAdapterCases(t, func() PlatformAdapter { return NewJunieAdapter() }, []adapterCase{
+		{
+			name:  "单文件并注明作用范围",
+			paths: []string{".junie/guidelines.md"},
+			contains: map[string][]string{
+				".junie/guidelines.md": {GeneratedMarker, "Never hardcode secrets.", "services/api/**/*.go", "Use camelCase."},
+			},
+			excludes: map[string][]string{".junie/guidelines.md": {"Never shown."}},
+		},
+	})
+}
@@ -0,0 +1,49 @@
+package platform
+
+import (
+	"strings"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+// JunieAdapter JetBrains Junie平台适配器
+// 全部规则写入 .junie/guidelines.md，带作用范围的规则在正文中注明适用的文件
+type JunieAdapter struct{}
+
+// NewJunieAdapter 创建新的Junie适配器
+func NewJunieAdapter() *JunieAdapter {
+	return &JunieAdapter{}
+}
+
+// Name 返回平台名称
+func (j *JunieAdapter) Name() string {
+	return "junie"
+}
+
+// DefaultOutputPath 返回Junie指南文件路径
+func (j *JunieAdapter) DefaultOutputPath() string {
+	return ".junie/guidelines.md"
+}
+
+// Convert 将统一规则转换为Junie格式
+func (j *JunieAdapter) Convert(ruleSet *rules.RuleSet) (*Output, error) {
+	ruleSet = withDefaultLanguage(ruleSet, rules.LangEN)
+	msg := messagesFor(ruleSet, rules.LangEN)
+
+	groups, err := GroupRules(ruleSet, GroupBySource)
+	if err != nil {
+		return nil, err
+	}
+
+	var content strings.Builder
+	content.WriteString("# " + msg.text("title.development_guidelines", ruleSet.Metadata.ProjectName) + "\n\n")
+	content.WriteString(generatedNotice(msg, j.Name()))
+	content.WriteString("\n")
+	writeProjectInfo(&content, msg, ruleSet.Metadata)
+	content.WriteString(msg.text("priority_order") + "\n\n")
+	for _, group := range groups {
+		writeScopedGroupMarkdown(&content, msg, group, 2)
+	}
+
+	return singleFileOutput(j.DefaultOutputPath(), markdownBytes(&content)), nil
+}
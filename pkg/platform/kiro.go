@@ -0,0 +1,174 @@
+package platform
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+// kiroSteeringDir Kiro steering 文件目录
+const kiroSteeringDir = ".kiro/steering"
+
+// Kiro 基础 steering 文件，分别描述产品、技术栈和项目结构
+const (
+	kiroProductFile   = "product"
+	kiroTechFile      = "tech"
+	kiroStructureFile = "structure"
+)
+
+// kiroTechTypes 写入 tech.md 的项目规则类型，其余项目规则写入 product.md
+var kiroTechTypes = map[string]bool{
+	"tech_stack": true,
+	"framework":  true,
+}
+
+// kiroStructureTypes 写入 structure.md 的规则类型
+var kiroStructureTypes = map[string]bool{
+	"structure":    true,
+	"architecture": true,
+}
+
+// KiroAdapter Kiro平台适配器
+// 始终加载的规则按 Kiro 的约定写入 product.md（项目规则）、tech.md（技术栈及全局、模板规则）
+// 和 structure.md（项目结构规则）；带 globs 的规则和按需引用的规则各自写入独立的 steering 文件
+type KiroAdapter struct{}
+
+// NewKiroAdapter 创建新的Kiro适配器
+func NewKiroAdapter() *KiroAdapter {
+	return &KiroAdapter{}
+}
+
+// Name 返回平台名称
+func (k *KiroAdapter) Name() string {
+	return "kiro"
+}
+
+// DefaultOutputPath 返回产品 steering 文件路径
+func (k *KiroAdapter) DefaultOutputPath() string {
+	return path.Join(kiroSteeringDir, kiroProductFile+".md")
+}
+
+// Convert 将统一规则转换为Kiro steering 文件
+func (k *KiroAdapter) Convert(ruleSet *rules.RuleSet) (*Output, error) {
+	ruleSet = withDefaultLanguage(ruleSet, rules.LangEN)
+	msg := messagesFor(ruleSet, rules.LangEN)
+
+	output := &Output{Owned: []string{path.Join(kiroSteeringDir, "*.md")}}
+
+	// 按规则来源和类型划分始终加载的基础 steering 文件
+	var product, tech, structure []rules.Rule
+	for _, section := range ruleSet.Sections() {
+		for _, rule := range section.Rules {
+			if !rule.Enabled || kiroInclusion(rule) != "always" {
+				continue
+			}
+			switch {
+			case kiroStructureTypes[rule.Type]:
+				structure = append(structure, rule)
+			case section.Source == rules.SourceProject && !kiroTechTypes[rule.Type]:
+				product = append(product, rule)
+			default:
+				tech = append(tech, rule)
+			}
+		}
+	}
+
+	var productContent strings.Builder
+	writeKiroFrontMatter(&productContent, "always", nil)
+	productContent.WriteString(generatedNotice(msg, k.Name()))
+	productContent.WriteString("\n")
+	productContent.WriteString("# " + msg.text("kiro.product") + "\n\n")
+	productContent.WriteString(fmt.Sprintf("- %s: %s\n\n", msg.text("label.project"), ruleSet.Metadata.ProjectName))
+	for _, rule := range product {
+		writeRuleMarkdown(&productContent, rule, 2)
+	}
+	output.Files = append(output.Files, OutputFile{Path: k.DefaultOutputPath(), Content: markdownBytes(&productContent)})
+
+	if len(tech) > 0 || len(ruleSet.Metadata.TechStacks) > 0 {
+		var techContent strings.Builder
+		writeKiroFrontMatter(&techContent, "always", nil)
+		techContent.WriteString(generatedNotice(msg, k.Name()))
+		techContent.WriteString("\n")
+		techContent.WriteString("# " + msg.text("kiro.tech") + "\n\n")
+		if len(ruleSet.Metadata.TechStacks) > 0 {
+			techContent.WriteString(fmt.Sprintf("- %s: %s\n\n", msg.text("label.tech_stack"), strings.Join(ruleSet.Metadata.TechStacks, ", ")))
+		}
+		for _, rule := range tech {
+			writeRuleMarkdown(&techContent, rule, 2)
+		}
+		output.Files = append(output.Files, OutputFile{
+			Path:    path.Join(kiroSteeringDir, kiroTechFile+".md"),
+			Content: markdownBytes(&techContent),
+		})
+	}
+
+	if len(structure) > 0 {
+		var structureContent strings.Builder
+		writeKiroFrontMatter(&structureContent, "always", nil)
+		structureContent.WriteString(generatedNotice(msg, k.Name()))
+		structureContent.WriteString("\n")
+		structureContent.WriteString("# " + msg.text("kiro.structure") + "\n\n")
+		for _, rule := range structure {
+			writeRuleMarkdown(&structureContent, rule, 2)
+		}
+		output.Files = append(output.Files, OutputFile{
+			Path:    path.Join(kiroSteeringDir, kiroStructureFile+".md"),
+			Content: markdownBytes(&structureContent),
+		})
+	}
+
+	// 按文件匹配或手动引用的规则各自生成一个 steering 文件，文件名不与基础 steering 文件重名
+	groups, err := GroupRules(filterRuleSet(ruleSet, func(rule rules.Rule) bool {
+		return kiroInclusion(rule) != "always"
+	}), GroupByRule, kiroProductFile, kiroTechFile, kiroStructureFile)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		rule := group.Rules[0]
+
+		var content strings.Builder
+		writeKiroFrontMatter(&content, kiroInclusion(rule), rule.Globs)
+		content.WriteString(generatedNotice(msg, k.Name()))
+		content.WriteString("\n")
+		writeRuleMarkdown(&content, rule, 1)
+
+		output.Files = append(output.Files, OutputFile{
+			Path:    path.Join(kiroSteeringDir, group.Name+".md"),
+			Content: markdownBytes(&content),
+		})
+	}
+
+	return output, nil
+}
+
+// kiroInclusion 返回规则对应的 Kiro inclusion 模式
+// Kiro 没有由 AI 决定加载的模式，此类规则按 manual 处理，可在对话中通过 #<文件名> 引用
+func kiroInclusion(rule rules.Rule) string {
+	switch ruleActivation(rule) {
+	case ActivationAlways:
+		return "always"
+	case ActivationGlob:
+		return "fileMatch"
+	default:
+		return "manual"
+	}
+}
+
+// writeKiroFrontMatter 写入 steering 文件的 front matter
+// fileMatchPattern 只接受单个模式，多个 globs 合并为 {a,b} 形式
+func writeKiroFrontMatter(content *strings.Builder, inclusion string, globs []string) {
+	content.WriteString("---\n")
+	content.WriteString(fmt.Sprintf("inclusion: %s\n", inclusion))
+	if inclusion == "fileMatch" {
+		pattern := strings.Join(globs, ",")
+		if len(globs) > 1 {
+			pattern = "{" + pattern + "}"
+		}
+		content.WriteString(fmt.Sprintf("fileMatchPattern: %s\n", strconv.Quote(pattern)))
+	}
+	content.WriteString("---\n\n")
+}
@@ -0,0 +1,59 @@
+package platform
+
+import (
+	"testing"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+func TestKiroAdapterConvert(t *testing.T) {
+	techRuleSet := testRuleSet()
+	techRuleSet.Metadata.TechStacks = []string{"Go"}
+	techRuleSet.ProjectRules = append(techRuleSet.ProjectRules,
+		rules.Rule{Title: "Layout", Type: "structure", Content: "Keep handlers in internal/.", Priority: 4, Enabled: true})
+	techRuleSet.GlobalRules[0].Priority = 4
+
+	collisionRuleSet := testRuleSet()
+	collisionRuleSet.GlobalRules = []rules.Rule{
+		{Title: "Product", Content: "Manual product rule.", Priority: 2, Enabled: true},
+		{Title: "Product 2", Content: "Another manual rule.", Priority: 2, Enabled: true},
+		{Title: "Tech", Globs: []string{"*.go", "go.mod"}, Content: "Go files.", Priority: 2, Enabled: true},
+	}
+
+	runAdapterCases(t, func() PlatformAdapter { return NewKiroAdapter() }, []adapterCase{
+		{
+			name:  "按 inclusion 模式划分",
+			paths: []string{".kiro/steering/product.md", ".kiro/steering/api-handlers.md", ".kiro/steering/naming.md"},
+			contains: map[string][]string{
+				".kiro/steering/product.md":      {"---\ninclusion: always\n---\n", GeneratedMarker, "- Project: demo", "Never hardcode secrets."},
+				".kiro/steering/api-handlers.md": {"---\ninclusion: fileMatch\nfileMatchPattern: \"services/api/**/*.go\"\n---\n", "Return JSON errors."},
+				".kiro/steering/naming.md":       {"---\ninclusion: manual\n---\n", "Use camelCase."},
+			},
+			excludes: map[string][]string{".kiro/steering/product.md": {"Return JSON errors.", "Use camelCase."}},
+		},
+		{
+			name:    "技术栈和项目结构",
+			ruleSet: techRuleSet,
+			paths:   []string{".kiro/steering/product.md", ".kiro/steering/tech.md", ".kiro/steering/structure.md", ".kiro/steering/api-handlers.md"},
+			contains: map[string][]string{
+				".kiro/steering/tech.md":      {"inclusion: always\n", "Go", "Use camelCase."},
+				".kiro/steering/structure.md": {"inclusion: always\n", "Keep handlers in internal/."},
+			},
+		},
+		{
+			name:    "不与基础 steering 文件重名",
+			ruleSet: collisionRuleSet,
+			paths: []string{
+				".kiro/steering/product.md",
+				".kiro/steering/api-handlers.md",
+				".kiro/steering/product-2.md",
+				".kiro/steering/product-2-2.md",
+				".kiro/steering/tech-2.md",
+			},
+			contains: map[string][]string{
+				".kiro/steering/product-2.md": {"Manual product rule."},
+				".kiro/steering/tech-2.md":    {"fileMatchPattern: \"{*.go,go.mod}\"\n"},
+			},
+		},
+	})
+}
@@ -0,0 +1,65 @@
+package platform
+
+import (
+	"fmt"
+	"strings"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+// GeneratedMarker 生成文件中的标识，用于识别由 pf_ruler 生成的文件
+const GeneratedMarker = "Generated by pf_ruler"
+
+// generatedNotice 返回写入 Markdown 文件的生成说明注释
+func generatedNotice(msg *messages, platform string) string {
+	return msg.text("notice", GeneratedMarker, platform) + "\n"
+}
+
+// markdownBytes 去除末尾多余空行，返回以单个换行结尾的文件内容
+func markdownBytes(content *strings.Builder) []byte {
+	return []byte(strings.TrimRight(content.String(), "\n") + "\n")
+}
+
+// writeProjectInfo 写入项目信息章节
+func writeProjectInfo(content *strings.Builder, msg *messages, metadata rules.Metadata) {
+	content.WriteString(fmt.Sprintf("## %s\n\n", msg.text("project_info")))
+	content.WriteString(fmt.Sprintf("- %s: %s\n", msg.text("label.project"), metadata.ProjectName))
+	if len(metadata.TechStacks) > 0 {
+		content.WriteString(fmt.Sprintf("- %s: %s\n", msg.text("label.tech_stack"), strings.Join(metadata.TechStacks, ", ")))
+	}
+	content.WriteString("\n")
+}
+
+// writeRuleMarkdown 以指定标题级别写入单条规则
+func writeRuleMarkdown(content *strings.Builder, rule rules.Rule, level int) {
+	content.WriteString(fmt.Sprintf("%s %s\n\n", strings.Repeat("#", level), rule.Title))
+	if rule.Description != "" {
+		content.WriteString(fmt.Sprintf("_%s_\n\n", rule.Description))
+	}
+	content.WriteString(fmt.Sprintf("%s\n\n", strings.TrimSpace(rule.Content)))
+}
+
+// writeGroupMarkdown 写入一组规则，组标题使用 level 级标题，规则使用 level+1 级标题
+func writeGroupMarkdown(content *strings.Builder, group RuleGroup, level int) {
+	content.WriteString(fmt.Sprintf("%s %s\n\n", strings.Repeat("#", level), group.Title))
+	for _, rule := range group.Rules {
+		writeRuleMarkdown(content, rule, level+1)
+	}
+}
+
+// writeScopedGroupMarkdown 写入一组规则，带作用范围的规则在描述中注明适用的文件
+// 用于无法通过 front matter 限定作用范围的单文件平台
+func writeScopedGroupMarkdown(content *strings.Builder, msg *messages, group RuleGroup, level int) {
+	content.WriteString(fmt.Sprintf("%s %s\n\n", strings.Repeat("#", level), group.Title))
+	for _, rule := range group.Rules {
+		writeScopedRuleMarkdown(content, msg, rule, level+1)
+	}
+}
+
+// writeScopedRuleMarkdown 写入单条规则，带作用范围时在描述中注明适用的文件
+func writeScopedRuleMarkdown(content *strings.Builder, msg *messages, rule rules.Rule, level int) {
+	if len(rule.Globs) > 0 {
+		rule.Description = strings.TrimSpace(msg.text("applies_to", rule.Description, strings.Join(rule.Globs, "`, `")))
+	}
+	writeRuleMarkdown(content, rule, level)
+}
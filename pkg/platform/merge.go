@@ -0,0 +1,293 @@
+package platform
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// readExistingFile 读取已有的用户文件，返回内容和文件权限
+// 文件不存在时返回 nil 内容和 DefaultFileMode
+func readExistingFile(filePath string) ([]byte, os.FileMode, error) {
+	info, err := os.Stat(filePath)
+	if os.IsNotExist(err) {
+		return nil, DefaultFileMode, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, 0, err
+	}
+	return data, info.Mode().Perm(), nil
+}
+
+// jsonField JSON 对象中的一个字段，保留原始值
+type jsonField struct {
+	Key   string
+	Value json.RawMessage
+}
+
+// parseJSONObject 按原有顺序解析 JSON 对象的顶层字段，空内容返回空列表
+// 合并配置时只修改目标字段，其他字段的顺序和内容保持不变
+func parseJSONObject(data []byte) ([]jsonField, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return nil, fmt.Errorf("顶层不是 JSON 对象")
+	}
+
+	var fields []jsonField
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, _ := token.(string)
+
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+		fields = append(fields, jsonField{Key: key, Value: value})
+	}
+
+	// 读取结束的 } 并确认之后没有多余内容
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("JSON 对象之后存在多余内容")
+	}
+
+	return fields, nil
+}
+
+// formatJSONObject 将字段按顺序格式化为使用 indent 缩进的 JSON 对象
+func formatJSONObject(fields []jsonField, indent string) ([]byte, error) {
+	var compact bytes.Buffer
+	compact.WriteString("{")
+	for i, field := range fields {
+		if i > 0 {
+			compact.WriteString(",")
+		}
+		key, err := json.Marshal(field.Key)
+		if err != nil {
+			return nil, err
+		}
+		compact.Write(key)
+		compact.WriteString(":")
+		compact.Write(field.Value)
+	}
+	compact.WriteString("}")
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, compact.Bytes(), "", indent); err != nil {
+		return nil, err
+	}
+	indented.WriteString("\n")
+	return indented.Bytes(), nil
+}
+
+// jsonIndent 返回 JSON 内容第一个缩进行使用的缩进，没有缩进行时返回两个空格
+func jsonIndent(data []byte) string {
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		trimmed := bytes.TrimLeft(line, " \t")
+		if len(trimmed) > 0 && len(trimmed) < len(line) {
+			return string(line[:len(line)-len(trimmed)])
+		}
+	}
+	return "  "
+}
+
+// mergeJSONListItem 确保 JSON 对象的 key 字段包含 item，返回合并后的内容及是否发生变化
+// key 可以是字符串或字符串数组（原值为字符串时转换为数组）；其他字段、顺序和缩进保持不变
+func mergeJSONListItem(data []byte, key, item string) ([]byte, bool, error) {
+	fields, err := parseJSONObject(data)
+	if err != nil {
+		return nil, false, err
+	}
+
+	index := -1
+	for i, field := range fields {
+		if field.Key == key {
+			index = i
+		}
+	}
+
+	// 已有的值保留在前面
+	var items []string
+	if index >= 0 {
+		var single string
+		if err := json.Unmarshal(fields[index].Value, &single); err == nil {
+			items = []string{single}
+		} else if err := json.Unmarshal(fields[index].Value, &items); err != nil {
+			return nil, false, fmt.Errorf("%s 既不是字符串也不是字符串数组", key)
+		}
+	}
+	for _, existing := range items {
+		if existing == item {
+			return data, false, nil
+		}
+	}
+
+	var value []byte
+	if len(items) == 0 {
+		value, err = json.Marshal(item)
+	} else {
+		value, err = json.Marshal(append(items, item))
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	if index >= 0 {
+		fields[index].Value = value
+	} else {
+		fields = append(fields, jsonField{Key: key, Value: value})
+	}
+
+	content, err := formatJSONObject(fields, jsonIndent(data))
+	if err != nil {
+		return nil, false, err
+	}
+	return content, true, nil
+}
+
+// mergeYAMLListItem 确保 YAML 映射的 key 字段包含 item，返回合并后的内容及是否发生变化
+// key 可以是字符串或字符串列表（原值为字符串时转换为列表）；其他字段、顺序和注释保持不变
+func mergeYAMLListItem(data []byte, key, item string) ([]byte, bool, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, false, err
+	}
+
+	newList := func() []*yaml.Node {
+		return []*yaml.Node{
+			{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+			{Kind: yaml.SequenceNode, Tag: "!!seq", Content: []*yaml.Node{{Kind: yaml.ScalarNode, Tag: "!!str", Value: item}}},
+		}
+	}
+
+	// 空文件、只有注释或只有 --- 的文件（如 Aider 的示例配置）：在原内容之后追加 key 列表，保留全部注释
+	if isEmptyYAML(&document) && singleYAMLDocument(data) {
+		block, err := encodeYAML(&yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Content: newList()})
+		if err != nil {
+			return nil, false, err
+		}
+		content := append([]byte{}, data...)
+		if len(content) > 0 && content[len(content)-1] != '\n' {
+			content = append(content, '\n')
+		}
+		return append(content, block...), true, nil
+	}
+
+	// 其他空文档（如 ~、null）：以新的映射代替空值，保留空值上的注释
+	if isEmptyYAML(&document) {
+		root := document.Content[0]
+		document.Content[0] = &yaml.Node{
+			Kind: yaml.MappingNode, Tag: "!!map", Content: newList(),
+			HeadComment: root.HeadComment, LineComment: root.LineComment, FootComment: root.FootComment,
+		}
+		content, err := encodeYAML(&document)
+		if err != nil {
+			return nil, false, err
+		}
+		return content, true, nil
+	}
+
+	root := document.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, false, fmt.Errorf("顶层不是 YAML 映射")
+	}
+
+	newItem := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: item}
+
+	var value *yaml.Node
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == key {
+			value = root.Content[i+1]
+		}
+	}
+
+	switch {
+	case value == nil:
+		root.Content = append(root.Content, newList()...)
+	case value.Kind == yaml.ScalarNode:
+		if value.Value == item {
+			return data, false, nil
+		}
+		existing := *value
+		*value = yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq", Content: []*yaml.Node{&existing, newItem}}
+	case value.Kind == yaml.SequenceNode:
+		for _, node := range value.Content {
+			if node.Value == item {
+				return data, false, nil
+			}
+		}
+		value.Content = append(value.Content, newItem)
+	default:
+		return nil, false, fmt.Errorf("%s 既不是字符串也不是列表", key)
+	}
+
+	content, err := encodeYAML(&document)
+	if err != nil {
+		return nil, false, err
+	}
+	return content, true, nil
+}
+
+// isEmptyYAML 判断 YAML 文档是否为空（没有内容、只有注释或值为 null）
+func isEmptyYAML(document *yaml.Node) bool {
+	if document.Kind == 0 || len(document.Content) == 0 {
+		return true
+	}
+	root := document.Content[0]
+	return root.Kind == yaml.ScalarNode && root.Tag == "!!null"
+}
+
+// singleYAMLDocument 判断空的 YAML 内容能否直接在末尾追加字段：
+// 没有显式的 null 值、文档结束标记 ...，且最多只有一个 --- 文档开始标记
+func singleYAMLDocument(data []byte) bool {
+	starts := 0
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+		case line == "---" || strings.HasPrefix(line, "--- #"):
+			starts++
+		default:
+			return false
+		}
+	}
+	return starts <= 1
+}
+
+// encodeYAML 以两个空格缩进编码 YAML 节点
+func encodeYAML(node *yaml.Node) ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(node); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,184 @@
+package platform
+
+import "testing"
+
+func TestMergeJSONListItem(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    string
+		changed bool
+		wantErr bool
+	}{
+		{
+			name:    "空文件",
+			data:    "",
+			want:    "{\n  \"contextFileName\": \"GEMINI.md\"\n}\n",
+			changed: true,
+		},
+		{
+			name:    "添加字段并保留其他字段和缩进",
+			data:    "{\n    \"theme\": \"dark\",\n    \"mcp\": {\"a\": 1}\n}\n",
+			want:    "{\n    \"theme\": \"dark\",\n    \"mcp\": {\n        \"a\": 1\n    },\n    \"contextFileName\": \"GEMINI.md\"\n}\n",
+			changed: true,
+		},
+		{
+			name:    "字符串转换为数组",
+			data:    "{\n\t\"contextFileName\": \"AGENTS.md\"\n}\n",
+			want:    "{\n\t\"contextFileName\": [\n\t\t\"AGENTS.md\",\n\t\t\"GEMINI.md\"\n\t]\n}\n",
+			changed: true,
+		},
+		{
+			name: "字符串已是目标值",
+			data: "{\"contextFileName\": \"GEMINI.md\"}",
+			want: "{\"contextFileName\": \"GEMINI.md\"}",
+		},
+		{
+			name: "数组已包含目标值",
+			data: "{\"contextFileName\": [\"AGENTS.md\", \"GEMINI.md\"]}",
+			want: "{\"contextFileName\": [\"AGENTS.md\", \"GEMINI.md\"]}",
+		},
+		{
+			name:    "值类型不正确",
+			data:    "{\"contextFileName\": 1}",
+			wantErr: true,
+		},
+		{
+			name:    "顶层不是对象",
+			data:    "[]",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed, err := mergeJSONListItem([]byte(tt.data), "contextFileName", "GEMINI.md")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("期望返回错误，实际为 nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("意外的错误: %v", err)
+			}
+			if changed != tt.changed {
+				t.Errorf("changed = %v，期望 %v", changed, tt.changed)
+			}
+			if string(got) != tt.want {
+				t.Errorf("合并结果 = %q，期望 %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImportPath(t *testing.T) {
+	tests := []struct {
+		from, target, want string
+	}{
+		{"GEMINI.md", ".gemini/rules/api.md", ".gemini/rules/api.md"},
+		{"docs/GEMINI.md", ".gemini/rules/api.md", "../.gemini/rules/api.md"},
+		{".gemini/GEMINI.md", ".gemini/rules/api.md", "rules/api.md"},
+	}
+
+	for _, tt := range tests {
+		if got := importPath(tt.from, tt.target); got != tt.want {
+			t.Errorf("importPath(%q, %q) = %q，期望 %q", tt.from, tt.target, got, tt.want)
+		}
+	}
+}
+
+func TestMergeYAMLListItem(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    string
+		changed bool
+		wantErr bool
+	}{
+		{
+			name:    "空文件",
+			data:    "",
+			want:    "read:\n  - CONVENTIONS.md\n",
+			changed: true,
+		},
+		{
+			name:    "只有注释的文件",
+			data:    "# Aider 示例配置\n#model: sonnet\n#read: []",
+			want:    "# Aider 示例配置\n#model: sonnet\n#read: []\nread:\n  - CONVENTIONS.md\n",
+			changed: true,
+		},
+		{
+			name:    "只有 --- 的空文档",
+			data:    "---\n",
+			want:    "---\nread:\n  - CONVENTIONS.md\n",
+			changed: true,
+		},
+		{
+			name:    "带注释的空文档",
+			data:    "# 项目配置\n---\n# 稍后填写\n",
+			want:    "# 项目配置\n---\n# 稍后填写\nread:\n  - CONVENTIONS.md\n",
+			changed: true,
+		},
+		{
+			name:    "值为 null 的文档",
+			data:    "~\n",
+			want:    "read:\n  - CONVENTIONS.md\n",
+			changed: true,
+		},
+		{
+			name:    "添加字段并保留注释",
+			data:    "# 模型配置\nmodel: sonnet\n",
+			want:    "# 模型配置\nmodel: sonnet\nread:\n  - CONVENTIONS.md\n",
+			changed: true,
+		},
+		{
+			name:    "字符串转换为列表",
+			data:    "read: NOTES.md\n",
+			want:    "read:\n  - NOTES.md\n  - CONVENTIONS.md\n",
+			changed: true,
+		},
+		{
+			name:    "列表追加",
+			data:    "read:\n  - NOTES.md\n",
+			want:    "read:\n  - NOTES.md\n  - CONVENTIONS.md\n",
+			changed: true,
+		},
+		{
+			name: "已包含目标值",
+			data: "read: [NOTES.md, CONVENTIONS.md]\n",
+			want: "read: [NOTES.md, CONVENTIONS.md]\n",
+		},
+		{
+			name:    "值类型不正确",
+			data:    "read:\n  a: b\n",
+			wantErr: true,
+		},
+		{
+			name:    "顶层不是映射",
+			data:    "- a\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed, err := mergeYAMLListItem([]byte(tt.data), "read", "CONVENTIONS.md")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("期望返回错误，实际为 nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("意外的错误: %v", err)
+			}
+			if changed != tt.changed {
+				t.Errorf("changed = %v，期望 %v", changed, tt.changed)
+			}
+			if string(got) != tt.want {
+				t.Errorf("合并结果 = %q，期望 %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package platform
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+const (
+	// openHandsMicroagentsDir OpenHands 微代理目录
+	openHandsMicroagentsDir = ".openhands/microagents"
+
+	// openHandsTriggerTagPrefix 指定触发关键词的标签前缀，如 trigger:golang
+	openHandsTriggerTagPrefix = "trigger:"
+)
+
+// OpenHandsAdapter OpenHands平台适配器
+// 没有触发关键词的规则写入始终加载的 .openhands/microagents/repo.md；
+// 带触发关键词的规则按关键词合并为 knowledge 微代理，只在对话中出现关键词时加载
+type OpenHandsAdapter struct {
+	// 作为触发关键词的普通标签（小写），如 go、java
+	triggerTags []string
+}
+
+// NewOpenHandsAdapter 创建新的OpenHands适配器
+func NewOpenHandsAdapter() *OpenHandsAdapter {
+	return &OpenHandsAdapter{}
+}
+
+// Name 返回平台名称
+func (o *OpenHandsAdapter) Name() string {
+	return "openhands"
+}
+
+// DefaultOutputPath 返回仓库微代理文件路径
+func (o *OpenHandsAdapter) DefaultOutputPath() string {
+	return path.Join(openHandsMicroagentsDir, "repo.md")
+}
+
+// Configure 应用平台选项
+//   - trigger_tags: 逗号分隔的标签列表，带这些标签的规则以标签作为触发关键词（如 go,golang,java）
+func (o *OpenHandsAdapter) Configure(options map[string]string) error {
+	if err := checkOptions(o.Name(), options, "trigger_tags"); err != nil {
+		return err
+	}
+
+	o.triggerTags = nil
+	for _, tag := range strings.Split(options["trigger_tags"], ",") {
+		if tag = strings.ToLower(strings.TrimSpace(tag)); tag != "" {
+			o.triggerTags = appendUniqueString(o.triggerTags, tag)
+		}
+	}
+
+	return nil
+}
+
+// Convert 将统一规则转换为OpenHands微代理，返回 repo.md 和各 knowledge 微代理文件
+func (o *OpenHandsAdapter) Convert(ruleSet *rules.RuleSet) (*Output, error) {
+	ruleSet = withDefaultLanguage(ruleSet, rules.LangEN)
+	msg := messagesFor(ruleSet, rules.LangEN)
+
+	groups, err := GroupRules(filterRuleSet(ruleSet, func(rule rules.Rule) bool {
+		return len(o.triggers(rule)) == 0
+	}), GroupBySource)
+	if err != nil {
+		return nil, err
+	}
+
+	var repo strings.Builder
+	repo.WriteString("---\n")
+	repo.WriteString("name: repo\n")
+	repo.WriteString("type: repo\n")
+	repo.WriteString("agent: CodeActAgent\n")
+	repo.WriteString("---\n\n")
+	repo.WriteString(generatedNotice(msg, o.Name()))
+	repo.WriteString("\n")
+	repo.WriteString("# " + msg.text("title.repository_rules", ruleSet.Metadata.ProjectName) + "\n\n")
+	writeProjectInfo(&repo, msg, ruleSet.Metadata)
+	repo.WriteString(msg.text("priority_order") + "\n\n")
+	for _, group := range groups {
+		writeScopedGroupMarkdown(&repo, msg, group, 2)
+	}
+
+	output := &Output{
+		Files: []OutputFile{{Path: o.DefaultOutputPath(), Content: markdownBytes(&repo)}},
+		Owned: []string{path.Join(openHandsMicroagentsDir, "*.md")},
+	}
+
+	// 触发关键词相同的规则合并为一个 knowledge 微代理，保持规则的优先级顺序
+	type knowledge struct {
+		name     string
+		triggers []string
+		rules    []rules.Rule
+	}
+	var agents []*knowledge
+	index := make(map[string]*knowledge)
+	used := map[string]bool{"repo": true}
+
+	for _, section := range ruleSet.Sections() {
+		for _, rule := range section.Rules {
+			triggers := o.triggers(rule)
+			if !rule.Enabled || len(triggers) == 0 {
+				continue
+			}
+
+			key := strings.Join(triggers, ",")
+			agent, exists := index[key]
+			if !exists {
+				agent = &knowledge{name: uniqueName(slugify(triggers[0]), used), triggers: triggers}
+				index[key] = agent
+				agents = append(agents, agent)
+			}
+			agent.rules = append(agent.rules, rule)
+		}
+	}
+
+	for _, agent := range agents {
+		var content strings.Builder
+		content.WriteString("---\n")
+		content.WriteString(fmt.Sprintf("name: %s\n", agent.name))
+		content.WriteString("type: knowledge\n")
+		content.WriteString("version: 1.0.0\n")
+		content.WriteString("agent: CodeActAgent\n")
+		content.WriteString("triggers:\n")
+		for _, trigger := range agent.triggers {
+			content.WriteString(fmt.Sprintf("- %s\n", strconv.Quote(trigger)))
+		}
+		content.WriteString("---\n\n")
+		content.WriteString(generatedNotice(msg, o.Name()))
+		content.WriteString("\n")
+		writeScopedGroupMarkdown(&content, msg, RuleGroup{Title: msg.text("openhands.knowledge_title", strings.Join(agent.triggers, ", ")), Rules: agent.rules}, 1)
+
+		output.Files = append(output.Files, OutputFile{
+			Path:    path.Join(openHandsMicroagentsDir, agent.name+".md"),
+			Content: markdownBytes(&content),
+		})
+	}
+
+	return output, nil
+}
+
+// triggers 返回规则排序后的触发关键词：trigger:<keyword> 标签，以及与 trigger_tags 选项匹配的普通标签
+func (o *OpenHandsAdapter) triggers(rule rules.Rule) []string {
+	var triggers []string
+	for _, tag := range rule.Tags {
+		lower := strings.ToLower(strings.TrimSpace(tag))
+		if strings.HasPrefix(lower, openHandsTriggerTagPrefix) {
+			if keyword := strings.TrimSpace(lower[len(openHandsTriggerTagPrefix):]); keyword != "" {
+				triggers = appendUniqueString(triggers, keyword)
+			}
+			continue
+		}
+		for _, triggerTag := range o.triggerTags {
+			if lower == triggerTag {
+				triggers = appendUniqueString(triggers, lower)
+			}
+		}
+	}
+
+	// 排序后相同关键词集合的规则会合并到同一个微代理
+	sort.Strings(triggers)
+	return triggers
+}
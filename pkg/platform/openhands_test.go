@@ -0,0 +1,54 @@
+package platform
+
+import "testing"
+
+func TestOpenHandsAdapterConvert(t *testing.T) {
+	triggerRuleSet := testRuleSet()
+	triggerRuleSet.ProjectRules[1].Tags = []string{"trigger:HTTP", "go"}
+	triggerRuleSet.GlobalRules[0].Tags = []string{"Go", "trigger:http"}
+	triggerRuleSet.GlobalRules[1].Tags = []string{"trigger:disabled"}
+
+	repoTriggerRuleSet := testRuleSet()
+	repoTriggerRuleSet.ProjectRules[0].Tags = []string{"trigger:repo"}
+
+	runAdapterCases(t, func() PlatformAdapter { return NewOpenHandsAdapter() }, []adapterCase{
+		{
+			name:  "没有触发关键词时只生成 repo 微代理",
+			paths: []string{".openhands/microagents/repo.md"},
+			contains: map[string][]string{".openhands/microagents/repo.md": {
+				"---\nname: repo\ntype: repo\nagent: CodeActAgent\n---\n", GeneratedMarker, "Never hardcode secrets.", "Use camelCase.",
+			}},
+		},
+		{
+			name:    "trigger 标签生成 knowledge 微代理",
+			ruleSet: triggerRuleSet,
+			paths:   []string{".openhands/microagents/repo.md", ".openhands/microagents/http.md"},
+			contains: map[string][]string{
+				".openhands/microagents/http.md": {
+					"---\nname: http\ntype: knowledge\nversion: 1.0.0\nagent: CodeActAgent\ntriggers:\n- \"http\"\n---\n",
+					"Return JSON errors.", "Use camelCase.",
+				},
+			},
+			excludes: map[string][]string{
+				".openhands/microagents/repo.md": {"Return JSON errors.", "Use camelCase."},
+			},
+		},
+		{
+			name:    "trigger_tags 选项把普通标签作为触发关键词",
+			options: map[string]string{"trigger_tags": " GO , go"},
+			ruleSet: triggerRuleSet,
+			paths:   []string{".openhands/microagents/repo.md", ".openhands/microagents/go.md"},
+			contains: map[string][]string{
+				".openhands/microagents/go.md": {"triggers:\n- \"go\"\n- \"http\"\n---\n", "Return JSON errors.", "Use camelCase."},
+			},
+		},
+		{
+			name:    "关键词与 repo 重名时改名",
+			ruleSet: repoTriggerRuleSet,
+			paths:   []string{".openhands/microagents/repo.md", ".openhands/microagents/repo-2.md"},
+			contains: map[string][]string{
+				".openhands/microagents/repo-2.md": {"name: repo-2\n", "triggers:\n- \"repo\"\n"},
+			},
+		},
+	})
+}
@@ -0,0 +1,58 @@
+package platform
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// checkOptions 校验选项名称均在 allowed 列表中
+func checkOptions(platform string, options map[string]string, allowed ...string) error {
+	known := make(map[string]bool, len(allowed))
+	for _, key := range allowed {
+		known[key] = true
+	}
+
+	keys := make([]string, 0, len(options))
+	for key := range options {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !known[key] {
+			return fmt.Errorf("%s 平台不支持选项 \"%s\"，可用选项：%v", platform, key, allowed)
+		}
+	}
+
+	return nil
+}
+
+// boolOption 读取布尔选项，未设置时返回默认值
+func boolOption(options map[string]string, key string, defaultValue bool) (bool, error) {
+	value, exists := options[key]
+	if !exists || value == "" {
+		return defaultValue, nil
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("选项 \"%s\" 的值 \"%s\" 不是有效的布尔值", key, value)
+	}
+	return parsed, nil
+}
+
+// enumOption 读取枚举选项，未设置时返回默认值
+func enumOption(options map[string]string, key, defaultValue string, allowed ...string) (string, error) {
+	value, exists := options[key]
+	if !exists || value == "" {
+		return defaultValue, nil
+	}
+
+	for _, candidate := range allowed {
+		if value == candidate {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("选项 \"%s\" 的值 \"%s\" 无效，可选值：%v", key, value, allowed)
+}
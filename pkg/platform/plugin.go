@@ -0,0 +1,356 @@
+package platform
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+// 插件协议
+//
+// 插件是名为 pf_ruler-adapter-<name> 的可执行文件，位于 .ruler/plugins/ 或 PATH 中。
+// pf_ruler 每次调用插件时向 stdin 写入一个 JSON 请求，并从 stdout 读取一个 JSON 响应；
+// stderr 的内容会在出错时附加到错误信息中。
+//
+// 握手请求：{"type": "handshake", "protocol_version": 1}
+// 握手响应：{"protocol_version": 1, "name": "<name>", "default_output_path": "<path>"}
+//
+// 转换请求：{"type": "convert", "protocol_version": 1, "rule_set": {...}, "options": {...}}
+// 转换响应：{"files": [{"path": "...", "content": "...", "mode": 420}], "warnings": [...], "owned": [...]}
+//
+// 任一响应包含非空的 "error" 字段时视为失败。
+const (
+	// PluginProtocolVersion 当前支持的插件协议版本
+	PluginProtocolVersion = 1
+
+	// PluginPrefix 插件可执行文件名前缀
+	PluginPrefix = "pf_ruler-adapter-"
+
+	// PluginDir 项目插件目录（相对 .ruler），优先于 PATH 中的同名插件
+	PluginDir = "plugins"
+
+	// pluginHandshakeTimeout 握手超时时间
+	pluginHandshakeTimeout = 5 * time.Second
+
+	// pluginConvertTimeout 默认转换超时时间，可通过 timeout 选项调整
+	pluginConvertTimeout = 30 * time.Second
+
+	// pluginWaitDelay 插件被终止或退出后等待 stdout/stderr 关闭的最长时间，
+	// 避免插件启动的后台进程持有输出管道时一直等待
+	pluginWaitDelay = time.Second
+
+	// pluginStderrLimit 错误信息中保留的 stderr 最大字节数
+	pluginStderrLimit = 2048
+)
+
+// pluginRequest 发送给插件的请求
+type pluginRequest struct {
+	Type            string            `json:"type"`
+	ProtocolVersion int               `json:"protocol_version"`
+	RuleSet         *rules.RuleSet    `json:"rule_set,omitempty"`
+	Options         map[string]string `json:"options,omitempty"`
+}
+
+// pluginHandshake 插件的握手响应
+type pluginHandshake struct {
+	ProtocolVersion   int    `json:"protocol_version"`
+	Name              string `json:"name"`
+	DefaultOutputPath string `json:"default_output_path"`
+	Error             string `json:"error"`
+}
+
+// pluginFile 插件返回的单个文件
+type pluginFile struct {
+	Path    string `json:"path"`
+	Content string `json:"content"`
+	Mode    uint32 `json:"mode"`
+}
+
+// pluginOutput 插件的转换响应
+type pluginOutput struct {
+	Files    []pluginFile `json:"files"`
+	Warnings []string     `json:"warnings"`
+	Owned    []string     `json:"owned"`
+	Error    string       `json:"error"`
+}
+
+// PluginAdapter 通过 stdin/stdout JSON 协议调用外部插件的平台适配器
+// 握手在首次使用时进行，未被选中的插件不会被执行
+type PluginAdapter struct {
+	name       string
+	executable string
+	options    map[string]string
+	timeout    time.Duration
+
+	handshake    *pluginHandshake
+	handshakeErr error
+}
+
+// Name 返回平台名称（可执行文件名去掉 pf_ruler-adapter- 前缀）
+func (p *PluginAdapter) Name() string {
+	return p.name
+}
+
+// Executable 返回插件可执行文件路径
+func (p *PluginAdapter) Executable() string {
+	return p.executable
+}
+
+// DefaultOutputPath 返回插件在握手中声明的默认输出路径，握手失败时返回空字符串
+func (p *PluginAdapter) DefaultOutputPath() string {
+	if err := p.ensureHandshake(); err != nil {
+		return ""
+	}
+	return p.handshake.DefaultOutputPath
+}
+
+// Configure 保存平台选项并在转换时原样发送给插件
+// timeout 选项（如 10s、1m）由 pf_ruler 使用，用于调整转换超时时间，不会发送给插件
+func (p *PluginAdapter) Configure(options map[string]string) error {
+	p.options = make(map[string]string, len(options))
+	for key, value := range options {
+		if key != "timeout" {
+			p.options[key] = value
+		}
+	}
+
+	if value, exists := options["timeout"]; exists && value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			return fmt.Errorf("选项 \"timeout\" 的值 \"%s\" 不是有效的时长（如 30s、1m）", value)
+		}
+		p.timeout = timeout
+	}
+
+	return nil
+}
+
+// Convert 将规则集发送给插件并返回插件生成的文件
+func (p *PluginAdapter) Convert(ruleSet *rules.RuleSet) (*Output, error) {
+	if err := p.ensureHandshake(); err != nil {
+		return nil, err
+	}
+
+	var response pluginOutput
+	request := pluginRequest{
+		Type:            "convert",
+		ProtocolVersion: PluginProtocolVersion,
+		RuleSet:         ruleSet,
+		Options:         p.options,
+	}
+	if err := p.call(request, p.timeout, &response); err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, fmt.Errorf("插件 %s 转换失败: %s", p.name, response.Error)
+	}
+
+	output := &Output{Warnings: response.Warnings, Owned: response.Owned}
+	for _, file := range response.Files {
+		if err := checkPluginPath(file.Path); err != nil {
+			return nil, fmt.Errorf("插件 %s 返回的文件路径无效: %w", p.name, err)
+		}
+		output.Files = append(output.Files, OutputFile{
+			Path:    file.Path,
+			Content: []byte(file.Content),
+			Mode:    os.FileMode(file.Mode).Perm(),
+		})
+	}
+	for _, pattern := range response.Owned {
+		if err := checkPluginPath(pattern); err != nil {
+			return nil, fmt.Errorf("插件 %s 返回的 owned 模式无效: %w", p.name, err)
+		}
+	}
+
+	return output, nil
+}
+
+// ensureHandshake 执行握手并缓存结果，校验协议版本和插件名称
+func (p *PluginAdapter) ensureHandshake() error {
+	if p.handshake != nil || p.handshakeErr != nil {
+		return p.handshakeErr
+	}
+
+	var response pluginHandshake
+	request := pluginRequest{Type: "handshake", ProtocolVersion: PluginProtocolVersion}
+	err := p.call(request, pluginHandshakeTimeout, &response)
+	switch {
+	case err != nil:
+	case response.Error != "":
+		err = fmt.Errorf("插件 %s 握手失败: %s", p.name, response.Error)
+	case response.ProtocolVersion != PluginProtocolVersion:
+		err = fmt.Errorf("插件 %s 使用协议版本 %d，当前 pf_ruler 支持版本 %d",
+			p.name, response.ProtocolVersion, PluginProtocolVersion)
+	case response.Name != p.name:
+		err = fmt.Errorf("插件 %s 在握手中声明的名称为 \"%s\"，与可执行文件名不一致", p.name, response.Name)
+	case checkPluginPath(response.DefaultOutputPath) != nil:
+		err = fmt.Errorf("插件 %s 的 default_output_path 无效: %w", p.name, checkPluginPath(response.DefaultOutputPath))
+	}
+
+	if err != nil {
+		p.handshakeErr = err
+		return err
+	}
+	p.handshake = &response
+	return nil
+}
+
+// call 执行插件，写入请求并解析响应
+func (p *PluginAdapter) call(request pluginRequest, timeout time.Duration, response interface{}) error {
+	input, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("序列化插件请求失败: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.executable)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.WaitDelay = pluginWaitDelay
+	setPluginProcessGroup(cmd)
+
+	err = cmd.Run()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("插件 %s 执行 %s 超时（%s）%s", p.name, request.Type, timeout, stderrSuffix(stderr.Bytes()))
+	}
+	if err != nil {
+		return fmt.Errorf("插件 %s 执行 %s 失败: %w%s", p.name, request.Type, err, stderrSuffix(stderr.Bytes()))
+	}
+
+	if err := json.Unmarshal(stdout.Bytes(), response); err != nil {
+		return fmt.Errorf("插件 %s 的 %s 响应不是有效的 JSON: %w%s", p.name, request.Type, err, stderrSuffix(stderr.Bytes()))
+	}
+	return nil
+}
+
+// stderrSuffix 返回附加到错误信息中的 stderr 内容（只保留末尾部分）
+func stderrSuffix(stderr []byte) string {
+	text := strings.TrimSpace(string(stderr))
+	if text == "" {
+		return ""
+	}
+	if len(text) > pluginStderrLimit {
+		text = "..." + text[len(text)-pluginStderrLimit:]
+	}
+	return "\nstderr: " + text
+}
+
+// checkPluginPath 校验插件返回或自定义平台声明的路径为项目内的相对路径
+func checkPluginPath(filePath string) error {
+	switch {
+	case strings.TrimSpace(filePath) == "":
+		return fmt.Errorf("路径为空")
+	case path.IsAbs(filePath) || filepath.IsAbs(filePath):
+		return fmt.Errorf("%s 不是相对路径", filePath)
+	case path.Clean(filepath.ToSlash(filePath)) == ".." || strings.HasPrefix(path.Clean(filepath.ToSlash(filePath)), "../"):
+		return fmt.Errorf("%s 超出了项目目录", filePath)
+	}
+	return nil
+}
+
+// DiscoverPlugins 查找 rulerDir/plugins 和 PATH 中的 pf_ruler-adapter-<name> 可执行文件
+// 同名插件以先找到的为准（项目插件目录优先，其次按 PATH 顺序），结果按名称排序
+func DiscoverPlugins(rulerDir string) []*PluginAdapter {
+	dirs := []string{filepath.Join(rulerDir, PluginDir)}
+	dirs = append(dirs, filepath.SplitList(os.Getenv("PATH"))...)
+
+	found := make(map[string]*PluginAdapter)
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			name, ok := pluginName(entry.Name())
+			if !ok || found[name] != nil {
+				continue
+			}
+
+			executable := filepath.Join(dir, entry.Name())
+			if !isExecutable(executable) {
+				continue
+			}
+			if !filepath.IsAbs(executable) {
+				if abs, err := filepath.Abs(executable); err == nil {
+					executable = abs
+				}
+			}
+
+			found[name] = &PluginAdapter{name: name, executable: executable, timeout: pluginConvertTimeout}
+		}
+	}
+
+	names := make([]string, 0, len(found))
+	for name := range found {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	plugins := make([]*PluginAdapter, 0, len(names))
+	for _, name := range names {
+		plugins = append(plugins, found[name])
+	}
+	return plugins
+}
+
+// RegisterPlugins 查找并注册外部插件，返回因与已注册平台重名而被忽略的插件
+// 内置平台和自定义平台优先于插件
+func (r *PlatformRegistry) RegisterPlugins(rulerDir string) []*PluginAdapter {
+	var shadowed []*PluginAdapter
+	for _, plugin := range DiscoverPlugins(rulerDir) {
+		if _, exists := r.Get(plugin.Name()); exists {
+			shadowed = append(shadowed, plugin)
+			continue
+		}
+		r.Register(plugin)
+	}
+	return shadowed
+}
+
+// pluginName 从可执行文件名中解析插件名称
+func pluginName(fileName string) (string, bool) {
+	if runtime.GOOS == "windows" {
+		fileName = strings.TrimSuffix(strings.ToLower(fileName), ".exe")
+	}
+	if !strings.HasPrefix(fileName, PluginPrefix) {
+		return "", false
+	}
+
+	name := strings.ToLower(strings.TrimPrefix(fileName, PluginPrefix))
+	if name == "" || name == AllPlatforms || strings.ContainsAny(name, ", .") {
+		return "", false
+	}
+	return name, true
+}
+
+// isExecutable 判断文件是否为可执行的普通文件
+func isExecutable(filePath string) bool {
+	info, err := os.Stat(filePath)
+	if err != nil || info.IsDir() {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return strings.EqualFold(filepath.Ext(filePath), ".exe")
+	}
+	return info.Mode().Perm()&0111 != 0
+}
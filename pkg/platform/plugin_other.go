@@ -0,0 +1,8 @@
+//go:build !unix
+
+package platform
+
+import "os/exec"
+
+// setPluginProcessGroup 非 Unix 系统只终止插件进程，子进程占用输出管道时由 WaitDelay 结束等待
+func setPluginProcessGroup(cmd *exec.Cmd) {}
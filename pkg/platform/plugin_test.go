@@ -0,0 +1,93 @@
+package platform
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeTestPlugin 在临时目录中创建名为 pf_ruler-adapter-<name> 的 shell 脚本插件
+func writeTestPlugin(t *testing.T, name, script string) *PluginAdapter {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("测试插件为 shell 脚本")
+	}
+
+	executable := filepath.Join(t.TempDir(), PluginPrefix+name)
+	if err := os.WriteFile(executable, []byte("#!/bin/sh\n"+script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return &PluginAdapter{name: name, executable: executable, timeout: pluginConvertTimeout}
+}
+
+func TestPluginAdapterHandshake(t *testing.T) {
+	plugin := writeTestPlugin(t, "acme", `cat >/dev/null
+echo '{"protocol_version": 1, "name": "acme", "default_output_path": "ACME.md"}'
+`)
+	if got := plugin.DefaultOutputPath(); got != "ACME.md" {
+		t.Errorf("DefaultOutputPath() = %q，期望 \"ACME.md\"", got)
+	}
+}
+
+func TestPluginAdapterTimeout(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+	}{
+		{
+			name:   "插件本身未结束",
+			script: "exec sleep 15\n",
+		},
+		{
+			name:   "插件的子进程持有 stdout",
+			script: "sleep 15\n",
+		},
+		{
+			name:   "插件的后台进程持有 stdout",
+			script: "sleep 15 &\nwait\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := writeTestPlugin(t, "slow", tt.script)
+
+			start := time.Now()
+			var response pluginHandshake
+			err := plugin.call(pluginRequest{Type: "handshake", ProtocolVersion: PluginProtocolVersion}, 200*time.Millisecond, &response)
+			elapsed := time.Since(start)
+
+			if err == nil || !strings.Contains(err.Error(), "超时") {
+				t.Fatalf("期望返回超时错误，实际为 %v", err)
+			}
+			if elapsed > 5*time.Second {
+				t.Errorf("超时后等待了 %s，插件的子进程未被终止", elapsed)
+			}
+		})
+	}
+}
+
+func TestPluginName(t *testing.T) {
+	tests := []struct {
+		fileName string
+		want     string
+		ok       bool
+	}{
+		{fileName: PluginPrefix + "acme", want: "acme", ok: true},
+		{fileName: PluginPrefix + "ACME", want: "acme", ok: true},
+		{fileName: PluginPrefix + "all"},
+		{fileName: PluginPrefix + "a.b"},
+		{fileName: PluginPrefix},
+		{fileName: "acme"},
+	}
+
+	for _, tt := range tests {
+		got, ok := pluginName(tt.fileName)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("pluginName(%q) = %q, %v，期望 %q, %v", tt.fileName, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+//go:build unix
+
+package platform
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setPluginProcessGroup 让插件在独立的进程组中运行，超时时终止整个进程组，
+// 插件启动的子进程不会在超时后继续运行
+func setPluginProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+}
@@ -0,0 +1,126 @@
+package platform
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+const (
+	// rooRulesDir Roo Code 通用规则目录，模式规则目录为 .roo/rules-<mode>
+	rooRulesDir = ".roo/rules"
+
+	// rooModeTagPrefix 指定 Roo Code 模式的标签前缀，如 mode:architect
+	rooModeTagPrefix = "mode:"
+)
+
+// RooAdapter Roo Code平台适配器
+// 没有模式标签的规则写入 .roo/rules/，带 mode:<mode> 标签的规则写入 .roo/rules-<mode>/，
+// 文件名与 Cline 相同，按规则优先级编号
+type RooAdapter struct {
+	groupBy string
+}
+
+// NewRooAdapter 创建新的Roo Code适配器
+func NewRooAdapter() *RooAdapter {
+	return &RooAdapter{groupBy: GroupByRule}
+}
+
+// Name 返回平台名称
+func (r *RooAdapter) Name() string {
+	return "roo"
+}
+
+// DefaultOutputPath 返回项目信息规则文件路径
+func (r *RooAdapter) DefaultOutputPath() string {
+	return path.Join(rooRulesDir, projectFileName+".md")
+}
+
+// Configure 应用平台选项
+//   - group_by: 分组方式 rule、type 或 source（默认 rule）
+func (r *RooAdapter) Configure(options map[string]string) error {
+	if err := checkOptions(r.Name(), options, "group_by"); err != nil {
+		return err
+	}
+
+	groupBy, err := enumOption(options, "group_by", r.groupBy, GroupByRule, GroupByType, GroupBySource)
+	if err != nil {
+		return err
+	}
+
+	r.groupBy = groupBy
+	return nil
+}
+
+// Convert 将统一规则转换为Roo Code格式，返回通用规则文件和各模式的规则文件
+func (r *RooAdapter) Convert(ruleSet *rules.RuleSet) (*Output, error) {
+	ruleSet = withDefaultLanguage(ruleSet, rules.LangEN)
+	msg := messagesFor(ruleSet, rules.LangEN)
+
+	groups, err := GroupRules(filterRuleSet(ruleSet, func(rule rules.Rule) bool {
+		return len(rooModes(rule)) == 0
+	}), r.groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	var project strings.Builder
+	project.WriteString(fmt.Sprintf("# %s\n\n", ruleSet.Metadata.ProjectName))
+	project.WriteString(generatedNotice(msg, r.Name()))
+	project.WriteString("\n")
+	writeProjectInfo(&project, msg, ruleSet.Metadata)
+	project.WriteString(msg.text("numbered_order") + "\n")
+
+	output := &Output{
+		Files: []OutputFile{{Path: r.DefaultOutputPath(), Content: markdownBytes(&project)}},
+		Owned: []string{path.Join(rooRulesDir, "*.md"), rooRulesDir + "-*/*.md"},
+	}
+	output.Files = append(output.Files, numberedRuleFiles(rooRulesDir, r.Name(), msg, groups)...)
+
+	// 收集全部模式并排序，保证输出顺序稳定
+	var modes []string
+	for _, section := range ruleSet.Sections() {
+		for _, rule := range section.Rules {
+			if rule.Enabled {
+				for _, mode := range rooModes(rule) {
+					modes = appendUniqueString(modes, mode)
+				}
+			}
+		}
+	}
+	sort.Strings(modes)
+
+	for _, mode := range modes {
+		mode := mode
+		modeGroups, err := GroupRules(filterRuleSet(ruleSet, func(rule rules.Rule) bool {
+			for _, m := range rooModes(rule) {
+				if m == mode {
+					return true
+				}
+			}
+			return false
+		}), r.groupBy)
+		if err != nil {
+			return nil, err
+		}
+
+		output.Files = append(output.Files, numberedRuleFiles(rooRulesDir+"-"+mode, r.Name(), msg, modeGroups)...)
+	}
+
+	return output, nil
+}
+
+// rooModes 返回规则通过 mode:<mode> 标签指定的 Roo Code 模式
+func rooModes(rule rules.Rule) []string {
+	var modes []string
+	for _, tag := range rule.Tags {
+		if len(tag) <= len(rooModeTagPrefix) || !strings.EqualFold(tag[:len(rooModeTagPrefix)], rooModeTagPrefix) {
+			continue
+		}
+		modes = appendUniqueString(modes, slugify(strings.TrimSpace(tag[len(rooModeTagPrefix):])))
+	}
+	return modes
+}
@@ -0,0 +1,44 @@
+package platform
+
+import "testing"
+
+func TestRooAdapterConvert(t *testing.T) {
+	modeRuleSet := testRuleSet()
+	modeRuleSet.ProjectRules[1].Tags = []string{"mode:Code Review"}
+	modeRuleSet.GlobalRules[0].Tags = []string{"mode:architect", "MODE:code-review"}
+
+	runAdapterCases(t, func() PlatformAdapter { return NewRooAdapter() }, []adapterCase{
+		{
+			name: "没有模式标签",
+			paths: []string{
+				".roo/rules/00-project.md",
+				".roo/rules/10-security.md",
+				".roo/rules/30-api-handlers.md",
+				".roo/rules/40-naming.md",
+			},
+		},
+		{
+			name:    "带模式标签的规则写入模式目录",
+			ruleSet: modeRuleSet,
+			paths: []string{
+				".roo/rules/00-project.md",
+				".roo/rules/10-security.md",
+				".roo/rules-architect/40-naming.md",
+				".roo/rules-code-review/30-api-handlers.md",
+				".roo/rules-code-review/40-naming.md",
+			},
+			contains: map[string][]string{
+				".roo/rules-architect/40-naming.md":         {GeneratedMarker, "Use camelCase."},
+				".roo/rules-code-review/30-api-handlers.md": {"Return JSON errors."},
+			},
+		},
+	})
+}
+
+func TestRooAdapterOwned(t *testing.T) {
+	output := convertWith(t, NewRooAdapter(), nil, testRuleSet())
+	want := []string{".roo/rules/*.md", ".roo/rules-*/*.md"}
+	if !equalStrings(output.Owned, want) {
+		t.Errorf("Owned = %q，期望 %q", output.Owned, want)
+	}
+}
@@ -0,0 +1,98 @@
+package platform
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+// singleFileMaxChars 单文件平台的默认字符数警告阈值
+// 这些编辑器会把规则文件完整放入每次对话的上下文，过长的文件会挤占上下文
+const singleFileMaxChars = 10000
+
+// singleFileAdapter 只读取项目根目录一个规则文件的平台适配器的公共实现
+// 各平台通过标题、开头说明和是否省略规则描述区分 Markdown 风格
+type singleFileAdapter struct {
+	name       string
+	outputPath string
+
+	// 文件标题的消息 ID，消息中的 %s 为项目名称
+	titleKey string
+
+	// 标题后说明的消息 ID，为空时不写说明
+	introKey string
+
+	// 是否省略规则描述，使输出更紧凑
+	compact bool
+
+	maxChars int
+}
+
+// Name 返回平台名称
+func (s *singleFileAdapter) Name() string {
+	return s.name
+}
+
+// DefaultOutputPath 返回规则文件路径
+func (s *singleFileAdapter) DefaultOutputPath() string {
+	return s.outputPath
+}
+
+// Configure 应用平台选项
+//   - max_chars: 规则文件的字符数警告阈值（默认 10000）
+func (s *singleFileAdapter) Configure(options map[string]string) error {
+	if err := checkOptions(s.name, options, "max_chars"); err != nil {
+		return err
+	}
+
+	if value := options["max_chars"]; value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			return fmt.Errorf("选项 \"max_chars\" 的值 \"%s\" 不是有效的正整数", value)
+		}
+		s.maxChars = parsed
+	}
+
+	return nil
+}
+
+// Convert 将统一规则转换为单个 Markdown 文件，超出字符数阈值时给出警告
+func (s *singleFileAdapter) Convert(ruleSet *rules.RuleSet) (*Output, error) {
+	ruleSet = withDefaultLanguage(ruleSet, rules.LangEN)
+	msg := messagesFor(ruleSet, rules.LangEN)
+
+	groups, err := GroupRules(ruleSet, GroupBySource)
+	if err != nil {
+		return nil, err
+	}
+
+	var content strings.Builder
+	content.WriteString("# " + msg.text(s.titleKey, ruleSet.Metadata.ProjectName) + "\n\n")
+	content.WriteString(generatedNotice(msg, s.name))
+	content.WriteString("\n")
+	if s.introKey != "" {
+		content.WriteString(msg.text(s.introKey) + "\n\n")
+	}
+	writeProjectInfo(&content, msg, ruleSet.Metadata)
+	content.WriteString(msg.text("priority_order") + "\n\n")
+
+	for _, group := range groups {
+		if s.compact {
+			for i := range group.Rules {
+				group.Rules[i].Description = ""
+			}
+		}
+		writeScopedGroupMarkdown(&content, msg, group, 2)
+	}
+
+	data := markdownBytes(&content)
+	output := singleFileOutput(s.outputPath, data)
+	if chars := utf8.RuneCount(data); chars > s.maxChars {
+		output.Warnings = append(output.Warnings, fmt.Sprintf("%s 共 %d 个字符，超过建议上限 %d，过长的规则文件会挤占对话上下文",
+			s.outputPath, chars, s.maxChars))
+	}
+	return output, nil
+}
@@ -0,0 +1,216 @@
+package platform
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+// builtinTemplates 内置的平台输出模板
+//
+//go:embed templates/*.tmpl
+var builtinTemplates embed.FS
+
+// TemplateOverrideDir 项目模板目录，其中的 <platform>.tmpl 和 <platform>.rule.tmpl 会覆盖内置模板
+var TemplateOverrideDir = filepath.Join(".ruler", "templates")
+
+// templateFuncs 规则模板中可用的函数
+var templateFuncs = template.FuncMap{
+	"join":           strings.Join,
+	"lower":          strings.ToLower,
+	"upper":          strings.ToUpper,
+	"trim":           strings.TrimSpace,
+	"quote":          strconv.Quote,
+	"joinTags":       joinTags,
+	"groupByType":    groupByType,
+	"sortByPriority": sortByPriority,
+}
+
+// joinTags 以逗号分隔连接规则标签
+func joinTags(tags []string) string {
+	return strings.Join(tags, ", ")
+}
+
+// groupByType 按规则类型分组，分组顺序和组内顺序保持规则的原始顺序
+func groupByType(ruleList []rules.Rule) []RuleGroup {
+	return groupByTypeTitled(ruleList, typeTitle)
+}
+
+// groupByTypeTitled 按规则类型分组，分组标题由 title 根据规则类型生成
+func groupByTypeTitled(ruleList []rules.Rule, title func(string) string) []RuleGroup {
+	var groups []RuleGroup
+	index := make(map[string]int)
+	used := make(map[string]bool)
+
+	for _, rule := range ruleList {
+		ruleType := rule.Type
+		if ruleType == "" {
+			ruleType = "general"
+		}
+		if i, exists := index[ruleType]; exists {
+			groups[i].Rules = append(groups[i].Rules, rule)
+			continue
+		}
+		index[ruleType] = len(groups)
+		groups = append(groups, RuleGroup{
+			Name:  uniqueName(slugify(ruleType), used),
+			Title: title(ruleType),
+			Rules: []rules.Rule{rule},
+		})
+	}
+
+	return groups
+}
+
+// sortByPriority 返回按优先级从高到低排序的规则副本，优先级相同的规则保持原始顺序
+func sortByPriority(ruleList []rules.Rule) []rules.Rule {
+	sorted := append([]rules.Rule(nil), ruleList...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Priority > sorted[j].Priority
+	})
+	return sorted
+}
+
+// ruleTemplateData 单条规则模板的数据，可直接访问规则字段
+type ruleTemplateData struct {
+	rules.Rule
+
+	// 标题级别及对应的 Markdown 标题前缀，如 3 和 ###
+	Level   int
+	Heading string
+}
+
+// platformTemplates 平台的整体文件模板和单条规则模板
+type platformTemplates struct {
+	file *template.Template
+	rule *template.Template
+}
+
+// loadPlatformTemplates 加载平台模板，项目模板目录中存在同名文件时优先使用
+// 整体文件模板中可以通过 {{ rule . 3 }} 以指定标题级别渲染单条规则，
+// 两种模板都可以通过 {{ t "label.type" }} 读取输出语言的消息，groupByType 的分组标题也使用输出语言
+func loadPlatformTemplates(platform string, msg *messages) (*platformTemplates, error) {
+	templates := &platformTemplates{}
+	localized := template.FuncMap{
+		"t": msg.text,
+		"groupByType": func(ruleList []rules.Rule) []RuleGroup {
+			return groupByTypeTitled(ruleList, msg.typeTitle)
+		},
+	}
+
+	ruleText, ruleSource, err := readPlatformTemplate(platform + ".rule.tmpl")
+	if err != nil {
+		return nil, err
+	}
+	if templates.rule, err = template.New(platform + ".rule.tmpl").Funcs(templateFuncs).Funcs(localized).Parse(ruleText); err != nil {
+		return nil, templateError(platform+".rule.tmpl", ruleSource, err)
+	}
+
+	fileText, fileSource, err := readPlatformTemplate(platform + ".tmpl")
+	if err != nil {
+		return nil, err
+	}
+	localized["rule"] = templates.renderRule
+	if templates.file, err = template.New(platform + ".tmpl").Funcs(templateFuncs).Funcs(localized).Parse(fileText); err != nil {
+		return nil, templateError(platform+".tmpl", fileSource, err)
+	}
+
+	return templates, nil
+}
+
+// readPlatformTemplate 读取项目模板目录中的模板，不存在时读取内置模板
+// 返回模板内容及项目模板的路径，使用内置模板时路径为空
+func readPlatformTemplate(name string) (string, string, error) {
+	overridePath := filepath.Join(TemplateOverrideDir, name)
+	data, err := os.ReadFile(overridePath)
+	if err == nil {
+		return string(data), overridePath, nil
+	}
+	if !os.IsNotExist(err) {
+		return "", "", fmt.Errorf("读取模板 %s 失败: %w", overridePath, err)
+	}
+
+	data, err = builtinTemplates.ReadFile(path.Join("templates", name))
+	if err != nil {
+		return "", "", fmt.Errorf("内置模板 %s 不存在", name)
+	}
+	return string(data), "", nil
+}
+
+// templateError 返回注明模板来源的解析错误
+func templateError(name, source string, err error) error {
+	if source == "" {
+		return fmt.Errorf("解析内置模板 %s 失败: %w", name, err)
+	}
+	return fmt.Errorf("解析模板 %s 失败: %w", source, err)
+}
+
+// renderRule 以指定标题级别渲染单条规则
+func (t *platformTemplates) renderRule(rule rules.Rule, level int) (string, error) {
+	var content strings.Builder
+	data := ruleTemplateData{Rule: rule, Level: level, Heading: strings.Repeat("#", level)}
+	if err := t.rule.Execute(&content, data); err != nil {
+		return "", fmt.Errorf("渲染规则 \"%s\" 失败: %w", rule.Title, err)
+	}
+	return content.String(), nil
+}
+
+// renderFile 渲染整体文件模板
+func (t *platformTemplates) renderFile(data interface{}) ([]byte, error) {
+	var content strings.Builder
+	if err := t.file.Execute(&content, data); err != nil {
+		return nil, fmt.Errorf("渲染模板 %s 失败: %w", t.file.Name(), err)
+	}
+	return []byte(content.String()), nil
+}
+
+// generatedAt 返回模板中的生成时间，取元数据的最后更新时间（默认为 SOURCE_DATE_EPOCH）而不是当前时间，
+// 未设置时返回空字符串，使重新生成的文件保持不变
+func generatedAt(metadata rules.Metadata) string {
+	if metadata.LastUpdatedAt.IsZero() {
+		return ""
+	}
+	return metadata.LastUpdatedAt.UTC().Format("2006-01-02 15:04:05 UTC")
+}
+
+// templateSection 整体文件模板中按 rule_priority 排列的规则来源
+type templateSection struct {
+	// 序号，从 1 开始
+	Number int
+
+	Source string
+	Title  string
+	Note   string
+
+	// 该来源中已启用的规则
+	Rules []rules.Rule
+}
+
+// templateSections 按 rule_priority 顺序返回各来源的标题、说明和已启用的规则
+// 说明取自消息目录中的 <notePrefix>.<来源>，如 trae.note.project
+func templateSections(ruleSet *rules.RuleSet, msg *messages, notePrefix string) []templateSection {
+	var sections []templateSection
+	for i, section := range ruleSet.Sections() {
+		data := templateSection{
+			Number: i + 1,
+			Source: section.Source,
+			Title:  msg.sourceTitle(section.Source),
+			Note:   msg.text(notePrefix + "." + section.Source),
+		}
+		for _, rule := range section.Rules {
+			if rule.Enabled {
+				data.Rules = append(data.Rules, rule)
+			}
+		}
+		sections = append(sections, data)
+	}
+	return sections
+}
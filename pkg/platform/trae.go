@@ -3,19 +3,37 @@ package platform
 import (
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
-	"time"
 
 	"github/pfinal/pf_ruler/pkg/rules"
 )
 
+// Trae 规则目录及用户规则的默认导出路径
+const (
+	traeRulesDir         = ".trae/rules"
+	traeDefaultUserRules = ".trae/user_rules.md"
+	traeProjectRulesFile = "project_rules"
+)
+
 // TraeAdapter Trae平台适配器
-type TraeAdapter struct{}
+// 默认将全部规则写入 .trae/rules/project_rules.md。
+// 开启 user_rules 后项目文件只包含项目规则，全局规则和模板规则导出为 Trae 的用户规则文件；
+// 开启 split 后规则按分组拆分为 .trae/rules/ 下的多个文件
+type TraeAdapter struct {
+	userRules     bool
+	userRulesPath string
+	split         bool
+	groupBy       string
+}
 
 // NewTraeAdapter 创建新的Trae适配器
 func NewTraeAdapter() *TraeAdapter {
-	return &TraeAdapter{}
+	return &TraeAdapter{
+		userRulesPath: traeDefaultUserRules,
+		groupBy:       GroupByType,
+	}
 }
 
 // Name 返回平台名称
@@ -25,99 +43,183 @@ func (t *TraeAdapter) Name() string {
 
 // DefaultOutputPath 返回Trae规则默认输出路径
 func (t *TraeAdapter) DefaultOutputPath() string {
-	return ".trae/rules/project_rules.md"
+	return path.Join(traeRulesDir, traeProjectRulesFile+".md")
 }
 
-// Convert 将统一规则转换为Trae格式
-func (t *TraeAdapter) Convert(ruleSet *rules.RuleSet) ([]byte, error) {
-	var content strings.Builder
-	
-	// 写入标题
-	content.WriteString(fmt.Sprintf("# %s 项目规则集\n\n", ruleSet.Metadata.ProjectName))
-	
-	// 写入元数据
-	content.WriteString("## 项目信息\n\n")
-	content.WriteString(fmt.Sprintf("- **项目名称**: %s\n", ruleSet.Metadata.ProjectName))
-	content.WriteString(fmt.Sprintf("- **技术栈**: %s\n", strings.Join(ruleSet.Metadata.TechStacks, ", ")))
-	content.WriteString(fmt.Sprintf("- **目标AI编辑器**: %s\n", strings.Join(ruleSet.Metadata.AIEditors, ", ")))
-	content.WriteString(fmt.Sprintf("- **生成时间**: %s\n", time.Now().Format("2006-01-02 15:04:05")))
-	content.WriteString(fmt.Sprintf("- **版本**: %s\n\n", ruleSet.Metadata.Version))
-	
-	// 写入项目规则（最高优先级）
-	if len(ruleSet.ProjectRules) > 0 {
-		content.WriteString("## 项目特定规则\n\n")
-		content.WriteString("*这些规则具有最高优先级，适用于当前项目*\n\n")
-		
-		for _, rule := range ruleSet.ProjectRules {
-			if !rule.Enabled {
-				continue
+// Configure 应用平台选项
+//   - user_rules: 是否将全局规则和模板规则导出为用户规则文件（默认 false）
+//   - user_rules_path: 用户规则文件路径，支持 ~/ 开头（默认 .trae/user_rules.md）
+//   - split: 是否将规则按分组拆分为 .trae/rules/ 下的多个文件（默认 false）
+//   - group_by: 拆分时的分组方式 type、source 或 rule（默认 type）
+func (t *TraeAdapter) Configure(options map[string]string) error {
+	if err := checkOptions(t.Name(), options, "user_rules", "user_rules_path", "split", "group_by"); err != nil {
+		return err
+	}
+
+	userRules, err := boolOption(options, "user_rules", t.userRules)
+	if err != nil {
+		return err
+	}
+
+	split, err := boolOption(options, "split", t.split)
+	if err != nil {
+		return err
+	}
+
+	groupBy, err := enumOption(options, "group_by", t.groupBy, GroupByType, GroupBySource, GroupByRule)
+	if err != nil {
+		return err
+	}
+
+	if value := strings.TrimSpace(options["user_rules_path"]); value != "" {
+		if strings.HasPrefix(value, "~/") {
+			home, err := os.UserHomeDir()
+			if err != nil {
+				return fmt.Errorf("无法解析 user_rules_path \"%s\": %w", value, err)
 			}
-			
-			content.WriteString(fmt.Sprintf("### %s\n\n", rule.Title))
-			content.WriteString(fmt.Sprintf("**类型**: %s  |  **优先级**: %d  |  **标签**: %s\n\n", 
-				rule.Type, rule.Priority, strings.Join(rule.Tags, ", ")))
-			content.WriteString(fmt.Sprintf("%s\n\n", rule.Description))
-			content.WriteString(fmt.Sprintf("**规则内容**:\n%s\n\n", rule.Content))
+			value = filepath.Join(home, value[2:])
 		}
+		t.userRulesPath = value
 	}
-	
-	// 写入全局规则（次优先级）
-	if len(ruleSet.GlobalRules) > 0 {
-		content.WriteString("## 全局通用规则\n\n")
-		content.WriteString("*这些规则适用于所有项目，具有中等优先级*\n\n")
-		
-		for _, rule := range ruleSet.GlobalRules {
-			if !rule.Enabled {
-				continue
+
+	t.userRules = userRules
+	t.split = split
+	t.groupBy = groupBy
+	return nil
+}
+
+// traeTemplateData Trae 主规则文件模板（trae.tmpl）的数据
+type traeTemplateData struct {
+	Platform string
+	Project  rules.Metadata
+
+	// SOURCE_DATE_EPOCH 指定的生成时间（UTC），未设置时为空
+	GeneratedAt string
+
+	// 按 rule_priority 排列的规则来源
+	Sections []templateSection
+
+	// 开启 split 时拆分出的规则文件，为空时规则直接写入主文件
+	Files []traeRuleFile
+
+	// 开启 user_rules 时用户规则文件的路径
+	UserRulesPath string
+}
+
+// traeRuleFile 拆分出的规则文件
+type traeRuleFile struct {
+	Title string
+	Path  string
+}
+
+// Convert 将统一规则转换为Trae格式
+// 主规则文件由 trae.tmpl 渲染，每条规则由 trae.rule.tmpl 渲染，两者均可在 .ruler/templates 中覆盖；
+// 未指定输出语言时使用中文
+func (t *TraeAdapter) Convert(ruleSet *rules.RuleSet) (*Output, error) {
+	ruleSet = withDefaultLanguage(ruleSet, rules.LangZhCN)
+	msg := messagesFor(ruleSet, rules.LangZhCN)
+
+	templates, err := loadPlatformTemplates(t.Name(), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	projectSet := ruleSet
+	if t.userRules {
+		projectSet = sourceRuleSet(ruleSet, rules.SourceProject)
+	}
+
+	output := &Output{
+		Files: []OutputFile{{Path: t.DefaultOutputPath()}},
+		Owned: []string{path.Join(traeRulesDir, "*.md")},
+	}
+
+	data := traeTemplateData{
+		Platform:    t.Name(),
+		Project:     ruleSet.Metadata,
+		GeneratedAt: generatedAt(ruleSet.Metadata),
+		Sections:    templateSections(projectSet, msg, "trae.note"),
+	}
+	if t.userRules {
+		data.UserRulesPath = t.userRulesPath
+	}
+
+	if t.split {
+		groups, err := GroupRules(projectSet, t.groupBy)
+		if err != nil {
+			return nil, err
+		}
+
+		// 主文件只保留规则文件列表，具体规则写入独立文件
+		// 分组名经过 slugify，不含下划线，不会与 project_rules.md 冲突
+		for _, group := range groups {
+			rulePath := path.Join(traeRulesDir, group.Name+".md")
+			data.Files = append(data.Files, traeRuleFile{Title: group.Title, Path: rulePath})
+
+			var groupContent strings.Builder
+			groupContent.WriteString(fmt.Sprintf("# %s\n\n", group.Title))
+			groupContent.WriteString(generatedNotice(msg, t.Name()))
+			groupContent.WriteString("\n")
+			for _, rule := range group.Rules {
+				rendered, err := templates.renderRule(rule, 2)
+				if err != nil {
+					return nil, err
+				}
+				groupContent.WriteString(rendered)
 			}
-			
-			content.WriteString(fmt.Sprintf("### %s\n\n", rule.Title))
-			content.WriteString(fmt.Sprintf("**类型**: %s  |  **优先级**: %d  |  **标签**: %s\n\n", 
-				rule.Type, rule.Priority, strings.Join(rule.Tags, ", ")))
-			content.WriteString(fmt.Sprintf("%s\n\n", rule.Description))
-			content.WriteString(fmt.Sprintf("**规则内容**:\n%s\n\n", rule.Content))
+			output.Files = append(output.Files, OutputFile{Path: rulePath, Content: markdownBytes(&groupContent)})
 		}
 	}
-	
-	// 写入模板规则（可选）
-	if len(ruleSet.TemplateRules) > 0 {
-		content.WriteString("## 自定义模板规则\n\n")
-		content.WriteString("*这些规则来自用户自定义模板*\n\n")
-		
-		for _, rule := range ruleSet.TemplateRules {
-			if !rule.Enabled {
-				continue
+
+	if output.Files[0].Content, err = templates.renderFile(data); err != nil {
+		return nil, err
+	}
+
+	if t.userRules {
+		userRules, err := t.convertUserRules(ruleSet, msg, templates)
+		if err != nil {
+			return nil, err
+		}
+		output.Files = append(output.Files, userRules)
+	}
+
+	return output, nil
+}
+
+// convertUserRules 生成包含全局规则和模板规则的用户规则文件
+func (t *TraeAdapter) convertUserRules(ruleSet *rules.RuleSet, msg *messages, templates *platformTemplates) (OutputFile, error) {
+	userSet := sourceRuleSet(ruleSet, rules.SourceGlobal, rules.SourceTemplates)
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf("# %s\n\n", msg.text("trae.user_rules_title")))
+	content.WriteString(generatedNotice(msg, t.Name()))
+	content.WriteString("\n")
+	content.WriteString(msg.text("trae.user_rules_intro") + "\n\n")
+
+	for _, section := range templateSections(userSet, msg, "trae.note") {
+		content.WriteString(fmt.Sprintf("## %s\n\n", section.Title))
+		content.WriteString(fmt.Sprintf("*%s*\n\n", section.Note))
+
+		for _, rule := range section.Rules {
+			rendered, err := templates.renderRule(rule, 3)
+			if err != nil {
+				return OutputFile{}, err
 			}
-			
-			content.WriteString(fmt.Sprintf("### %s\n\n", rule.Title))
-			content.WriteString(fmt.Sprintf("**类型**: %s  |  **优先级**: %d  |  **标签**: %s\n\n", 
-				rule.Type, rule.Priority, strings.Join(rule.Tags, ", ")))
-			content.WriteString(fmt.Sprintf("%s\n\n", rule.Description))
-			content.WriteString(fmt.Sprintf("**规则内容**:\n%s\n\n", rule.Content))
+			content.WriteString(rendered)
 		}
 	}
-	
-	// 写入使用说明
-	content.WriteString("## 使用说明\n\n")
-	content.WriteString("本规则集由 pf_ruler 工具自动生成，用于指导 AI 编辑器生成符合项目规范的代码。\n\n")
-	content.WriteString("### 规则优先级\n\n")
-	content.WriteString("1. **项目特定规则** - 最高优先级，覆盖其他规则\n")
-	content.WriteString("2. **全局通用规则** - 中等优先级，适用于所有项目\n")
-	content.WriteString("3. **自定义模板规则** - 可选，来自用户配置\n\n")
-	content.WriteString("### 更新规则\n\n")
-	content.WriteString("如需更新规则，请修改 `.ruler` 目录下的相应文件，然后重新运行 `pf_ruler generate --platform=trae` 命令。\n")
-	
-	return []byte(content.String()), nil
+
+	return OutputFile{Path: t.userRulesPath, Content: markdownBytes(&content)}, nil
 }
 
 // EnsureOutputDirectory 确保输出目录存在
 func (t *TraeAdapter) EnsureOutputDirectory() error {
 	outputPath := t.DefaultOutputPath()
 	outputDir := filepath.Dir(outputPath)
-	
+
 	if err := os.MkdirAll(outputDir, 0755); err != nil {
 		return fmt.Errorf("创建输出目录失败: %w", err)
 	}
-	
+
 	return nil
 }
@@ -0,0 +1,62 @@
+package platform
+
+import "testing"
+
+func TestTraeAdapterConvert(t *testing.T) {
+	runAdapterCases(t, func() PlatformAdapter { return NewTraeAdapter() }, []adapterCase{
+		{
+			name:  "默认写入单个中文规则文件",
+			paths: []string{".trae/rules/project_rules.md"},
+			contains: map[string][]string{".trae/rules/project_rules.md": {
+				"# demo 项目规则集", "## 项目信息", "Never hardcode secrets.", "Use camelCase.",
+			}},
+			excludes: map[string][]string{".trae/rules/project_rules.md": {"Never shown."}},
+		},
+		{
+			name:    "user_rules 导出全局规则",
+			options: map[string]string{"user_rules": "true"},
+			paths:   []string{".trae/rules/project_rules.md", ".trae/user_rules.md"},
+			contains: map[string][]string{
+				".trae/rules/project_rules.md": {"Never hardcode secrets.", ".trae/user_rules.md"},
+				".trae/user_rules.md":          {GeneratedMarker, "Use camelCase."},
+			},
+			excludes: map[string][]string{
+				".trae/rules/project_rules.md": {"Use camelCase."},
+				".trae/user_rules.md":          {"Never hardcode secrets."},
+			},
+		},
+		{
+			name:    "split 按类型拆分规则文件",
+			options: map[string]string{"split": "true"},
+			paths: []string{
+				".trae/rules/project_rules.md",
+				".trae/rules/security.md",
+				".trae/rules/code-style.md",
+				".trae/rules/naming.md",
+			},
+			contains: map[string][]string{
+				".trae/rules/project_rules.md": {"`.trae/rules/security.md`", "`.trae/rules/naming.md`"},
+				".trae/rules/security.md":      {GeneratedMarker, "Never hardcode secrets."},
+			},
+			excludes: map[string][]string{".trae/rules/project_rules.md": {"Never hardcode secrets."}},
+		},
+		{
+			name:    "split 按规则拆分",
+			options: map[string]string{"split": "true", "group_by": "rule"},
+			paths: []string{
+				".trae/rules/project_rules.md",
+				".trae/rules/security.md",
+				".trae/rules/api-handlers.md",
+				".trae/rules/naming.md",
+			},
+		},
+	})
+}
+
+func TestTraeAdapterOwned(t *testing.T) {
+	output := convertWith(t, NewTraeAdapter(), map[string]string{"user_rules": "true"}, testRuleSet())
+	want := []string{".trae/rules/*.md"}
+	if !equalStrings(output.Owned, want) {
+		t.Errorf("Owned = %q，期望 %q", output.Owned, want)
+	}
+}
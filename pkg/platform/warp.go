@@ -0,0 +1,18 @@
+package platform
+
+// WarpAdapter Warp平台适配器
+// Warp 读取项目根目录的 WARP.md 作为项目规则
+type WarpAdapter struct {
+	singleFileAdapter
+}
+
+// NewWarpAdapter 创建新的Warp适配器
+func NewWarpAdapter() *WarpAdapter {
+	return &WarpAdapter{singleFileAdapter{
+		name:       "warp",
+		outputPath: "WARP.md",
+		titleKey:   "title.warp",
+		introKey:   "warp.intro",
+		maxChars:   singleFileMaxChars,
+	}}
+}
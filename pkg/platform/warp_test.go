@@ -0,0 +1,22 @@
+package platform
+
+import "testing"
+
+func TestWarpAdapterConvert(t *testing.T) {
+	runAdapterCases(t, func() PlatformAdapter { return NewWarpAdapter() }, []adapterCase{
+		{
+			name:  "保留规则描述并写入说明",
+			paths: []string{"WARP.md"},
+			contains: map[string][]string{"WARP.md": {
+				"# WARP.md - demo", "This file provides guidance to Warp", "HTTP handler conventions", "Use camelCase.",
+			}},
+			excludes: map[string][]string{"WARP.md": {"Never shown."}},
+		},
+		{
+			name:     "超出字符数阈值时警告",
+			options:  map[string]string{"max_chars": "100"},
+			paths:    []string{"WARP.md"},
+			warnings: 1,
+		},
+	})
+}
@@ -0,0 +1,265 @@
+package platform
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github/pfinal/pf_ruler/pkg/rules"
+)
+
+// Windsurf 输出模式
+const (
+	// WindsurfModeRules 在 .windsurf/rules/ 下为每条规则（或每个分组）生成带 trigger front matter 的 .md 文件
+	WindsurfModeRules = "rules"
+
+	// WindsurfModeLegacy 生成项目根目录的单个 .windsurfrules 文件
+	WindsurfModeLegacy = "legacy"
+)
+
+const (
+	// windsurfRulesDir 规则文件所在目录
+	windsurfRulesDir = ".windsurf/rules"
+
+	// windsurfMaxFileChars Windsurf 单个规则文件的字符数上限，超出部分会被截断
+	windsurfMaxFileChars = 6000
+)
+
+// windsurfTriggers 激活方式对应的 Windsurf trigger 取值
+var windsurfTriggers = map[Activation]string{
+	ActivationAlways:        "always_on",
+	ActivationGlob:          "glob",
+	ActivationModelDecision: "model_decision",
+	ActivationManual:        "manual",
+}
+
+// WindsurfAdapter Windsurf平台适配器
+// 默认在 .windsurf/rules/ 下生成规则文件，legacy 模式生成 .windsurfrules；
+// 分组超出单文件字符数上限时拆分为多个文件，单条规则本身超限时在警告中列出
+type WindsurfAdapter struct {
+	mode         string
+	groupBy      string
+	maxFileChars int
+}
+
+// NewWindsurfAdapter 创建新的Windsurf适配器
+func NewWindsurfAdapter() *WindsurfAdapter {
+	return &WindsurfAdapter{
+		mode:         WindsurfModeRules,
+		groupBy:      GroupByRule,
+		maxFileChars: windsurfMaxFileChars,
+	}
+}
+
+// Name 返回平台名称
+func (w *WindsurfAdapter) Name() string {
+	return "windsurf"
+}
+
+// DefaultOutputPath 返回Windsurf规则默认输出路径
+// rules 模式下为项目信息规则文件，legacy 模式下为项目根目录的 .windsurfrules 文件
+func (w *WindsurfAdapter) DefaultOutputPath() string {
+	if w.mode == WindsurfModeLegacy {
+		return ".windsurfrules"
+	}
+	return path.Join(windsurfRulesDir, projectFileName+".md")
+}
+
+// Configure 应用平台选项
+//   - mode: 输出模式 rules 或 legacy（默认 rules）
+//   - group_by: rules 模式下的分组方式 rule、type 或 source（默认 rule）
+//   - max_file_chars: 单个规则文件的字符数上限（默认 6000）
+func (w *WindsurfAdapter) Configure(options map[string]string) error {
+	if err := checkOptions(w.Name(), options, "mode", "group_by", "max_file_chars"); err != nil {
+		return err
+	}
+
+	mode, err := enumOption(options, "mode", w.mode, WindsurfModeRules, WindsurfModeLegacy)
+	if err != nil {
+		return err
+	}
+
+	groupBy, err := enumOption(options, "group_by", w.groupBy, GroupByRule, GroupByType, GroupBySource)
+	if err != nil {
+		return err
+	}
+
+	if value := options["max_file_chars"]; value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			return fmt.Errorf("选项 \"max_file_chars\" 的值 \"%s\" 不是有效的正整数", value)
+		}
+		w.maxFileChars = parsed
+	}
+
+	w.mode = mode
+	w.groupBy = groupBy
+	return nil
+}
+
+// Convert 将统一规则转换为Windsurf格式
+// 两种模式都声明拥有 .windsurfrules 和 .windsurf/rules/*.md，切换模式或删除规则后，
+// 之前生成的文件会被清理
+func (w *WindsurfAdapter) Convert(ruleSet *rules.RuleSet) (*Output, error) {
+	ruleSet = withDefaultLanguage(ruleSet, rules.LangEN)
+	msg := messagesFor(ruleSet, rules.LangEN)
+
+	output := &Output{
+		Owned: []string{".windsurfrules", path.Join(windsurfRulesDir, "*.md")},
+	}
+
+	var truncated []string
+	if w.mode == WindsurfModeLegacy {
+		output.Files, truncated = w.convertLegacy(ruleSet, msg)
+	} else {
+		files, rulesTruncated, err := w.convertRules(ruleSet, msg)
+		if err != nil {
+			return nil, err
+		}
+		output.Files, truncated = files, rulesTruncated
+	}
+
+	if len(truncated) > 0 {
+		output.Warnings = append(output.Warnings, fmt.Sprintf("以下 %d 条规则超出 Windsurf 单文件 %d 个字符的上限，超出部分会被截断：%s",
+			len(truncated), w.maxFileChars, strings.Join(truncated, "、")))
+	}
+
+	return output, nil
+}
+
+// convertRules 生成 .windsurf/rules/*.md 文件，返回文件列表和被截断的规则标题
+// 第一个文件为始终加载的项目信息；分组超出字符数上限时按规则拆分为多个文件
+func (w *WindsurfAdapter) convertRules(ruleSet *rules.RuleSet, msg *messages) ([]OutputFile, []string, error) {
+	groups, err := GroupRules(ruleSet, w.groupBy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var project strings.Builder
+	writeWindsurfFrontMatter(&project, ActivationAlways, "", nil)
+	project.WriteString(generatedNotice(msg, w.Name()))
+	project.WriteString("\n")
+	project.WriteString(fmt.Sprintf("# %s\n\n", ruleSet.Metadata.ProjectName))
+	writeProjectInfo(&project, msg, ruleSet.Metadata)
+	project.WriteString(msg.text("generated_from", windsurfRulesDir))
+	sources := make([]string, 0, len(ruleSet.Sections()))
+	for _, section := range ruleSet.Sections() {
+		sources = append(sources, msg.sourceTitle(section.Source))
+	}
+	project.WriteString(msg.text("source_precedence", strings.Join(sources, " > ")) + "\n")
+
+	files := []OutputFile{{Path: w.DefaultOutputPath(), Content: markdownBytes(&project)}}
+	var truncated []string
+
+	for _, group := range groups {
+		parts := w.splitGroup(group, msg)
+		for i, part := range parts {
+			name := group.Name
+			if len(parts) > 1 {
+				name = fmt.Sprintf("%s-part-%d", group.Name, i+1)
+			}
+
+			content := w.renderGroup(part, msg)
+			if utf8.RuneCount(content) > w.maxFileChars {
+				for _, rule := range part.Rules {
+					truncated = append(truncated, rule.Title)
+				}
+			}
+
+			files = append(files, OutputFile{
+				Path:    path.Join(windsurfRulesDir, name+".md"),
+				Content: content,
+			})
+		}
+	}
+
+	return files, truncated, nil
+}
+
+// splitGroup 将超出字符数上限的分组按规则顺序拆分为多个分组
+// 单条规则本身超限时单独成组，由调用方标记为截断
+func (w *WindsurfAdapter) splitGroup(group RuleGroup, msg *messages) []RuleGroup {
+	if utf8.RuneCount(w.renderGroup(group, msg)) <= w.maxFileChars {
+		return []RuleGroup{group}
+	}
+
+	var parts []RuleGroup
+	current := RuleGroup{Name: group.Name, Title: group.Title}
+	for _, rule := range group.Rules {
+		candidate := current
+		candidate.Rules = append(append([]rules.Rule{}, current.Rules...), rule)
+		if len(current.Rules) > 0 && utf8.RuneCount(w.renderGroup(candidate, msg)) > w.maxFileChars {
+			parts = append(parts, current)
+			candidate.Rules = []rules.Rule{rule}
+		}
+		current = candidate
+	}
+	parts = append(parts, current)
+
+	for i := range parts {
+		parts[i].Title = fmt.Sprintf("%s (%d/%d)", group.Title, i+1, len(parts))
+	}
+	return parts
+}
+
+// renderGroup 生成一个规则文件的完整内容，front matter 由组内规则的优先级、标签和作用范围推断
+func (w *WindsurfAdapter) renderGroup(group RuleGroup, msg *messages) []byte {
+	activation, globs := groupActivation(group.Rules)
+
+	var content strings.Builder
+	writeWindsurfFrontMatter(&content, activation, groupDescription(group), globs)
+	content.WriteString(generatedNotice(msg, w.Name()))
+	content.WriteString("\n")
+	if len(group.Rules) == 1 {
+		writeRuleMarkdown(&content, group.Rules[0], 1)
+	} else {
+		writeGroupMarkdown(&content, group, 1)
+	}
+
+	return markdownBytes(&content)
+}
+
+// writeWindsurfFrontMatter 写入规则文件的 front matter
+// model_decision 规则依赖 description 判断是否加载，glob 规则需要 globs；其余字段省略
+func writeWindsurfFrontMatter(content *strings.Builder, activation Activation, description string, globs []string) {
+	content.WriteString("---\n")
+	content.WriteString(fmt.Sprintf("trigger: %s\n", windsurfTriggers[activation]))
+	switch activation {
+	case ActivationModelDecision:
+		content.WriteString(fmt.Sprintf("description: %s\n", strings.Join(strings.Fields(description), " ")))
+	case ActivationGlob:
+		content.WriteString(fmt.Sprintf("globs: %s\n", strings.Join(globs, ",")))
+	}
+	content.WriteString("---\n\n")
+}
+
+// convertLegacy 生成 legacy 模式的 .windsurfrules 文件，返回文件和被截断的规则标题
+// 单文件超出字符数上限时，结尾位于上限之后的规则会被 Windsurf 截断
+func (w *WindsurfAdapter) convertLegacy(ruleSet *rules.RuleSet, msg *messages) ([]OutputFile, []string) {
+	var content strings.Builder
+	var truncated []string
+
+	content.WriteString("# " + msg.text("title.windsurf_rules", ruleSet.Metadata.ProjectName) + "\n\n")
+	content.WriteString(generatedNotice(msg, w.Name()))
+	content.WriteString("\n")
+	writeProjectInfo(&content, msg, ruleSet.Metadata)
+
+	for _, section := range ruleSet.Sections() {
+		content.WriteString(fmt.Sprintf("## %s\n\n", msg.sourceTitle(section.Source)))
+
+		for _, rule := range section.Rules {
+			if !rule.Enabled {
+				continue
+			}
+
+			writeRuleMarkdown(&content, rule, 3)
+			if utf8.RuneCountInString(content.String()) > w.maxFileChars {
+				truncated = append(truncated, rule.Title)
+			}
+		}
+	}
+
+	return []OutputFile{{Path: w.DefaultOutputPath(), Content: markdownBytes(&content)}}, truncated
+}
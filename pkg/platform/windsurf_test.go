@@ -0,0 +1,58 @@
+package platform
+
+import "testing"
+
+func TestWindsurfAdapterConvert(t *testing.T) {
+	runAdapterCases(t, func() PlatformAdapter { return NewWindsurfAdapter() }, []adapterCase{
+		{
+			name: "按规则生成并推断 trigger",
+			paths: []string{
+				".windsurf/rules/00-project.md",
+				".windsurf/rules/security.md",
+				".windsurf/rules/api-handlers.md",
+				".windsurf/rules/naming.md",
+			},
+			contains: map[string][]string{
+				".windsurf/rules/00-project.md":   {"---\ntrigger: always_on\n---\n"},
+				".windsurf/rules/security.md":     {"---\ntrigger: always_on\n---\n", GeneratedMarker, "Never hardcode secrets."},
+				".windsurf/rules/api-handlers.md": {"---\ntrigger: glob\nglobs: services/api/**/*.go\n---\n"},
+				".windsurf/rules/naming.md":       {"---\ntrigger: model_decision\ndescription: Naming conventions\n---\n"},
+			},
+		},
+		{
+			name:    "超出字符数上限的分组按规则拆分",
+			options: map[string]string{"group_by": "source", "max_file_chars": "230"},
+			paths: []string{
+				".windsurf/rules/00-project.md",
+				".windsurf/rules/project-part-1.md",
+				".windsurf/rules/project-part-2.md",
+				".windsurf/rules/global.md",
+			},
+			contains: map[string][]string{
+				".windsurf/rules/project-part-1.md": {"trigger: always_on\n", "Never hardcode secrets."},
+				".windsurf/rules/project-part-2.md": {"trigger: glob\n", "Return JSON errors."},
+			},
+		},
+		{
+			name:     "单条规则超出上限时警告",
+			options:  map[string]string{"group_by": "source", "max_file_chars": "220"},
+			paths:    []string{".windsurf/rules/00-project.md", ".windsurf/rules/project-part-1.md", ".windsurf/rules/project-part-2.md", ".windsurf/rules/global.md"},
+			warnings: 1,
+		},
+		{
+			name:    "legacy 模式",
+			options: map[string]string{"mode": "legacy"},
+			paths:   []string{".windsurfrules"},
+			contains: map[string][]string{
+				".windsurfrules": {GeneratedMarker, "Never hardcode secrets.", "Use camelCase."},
+			},
+			excludes: map[string][]string{".windsurfrules": {"trigger:", "Never shown."}},
+		},
+		{
+			name:     "legacy 模式超出上限时警告",
+			options:  map[string]string{"mode": "legacy", "max_file_chars": "300"},
+			paths:    []string{".windsurfrules"},
+			warnings: 1,
+		},
+	})
+}
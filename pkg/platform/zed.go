@@ -0,0 +1,18 @@
+package platform
+
+// ZedAdapter Zed平台适配器
+// Zed 的 Agent 读取项目根目录的 .rules 文件，使用省略规则描述的紧凑格式
+type ZedAdapter struct {
+	singleFileAdapter
+}
+
+// NewZedAdapter 创建新的Zed适配器
+func NewZedAdapter() *ZedAdapter {
+	return &ZedAdapter{singleFileAdapter{
+		name:       "zed",
+		outputPath: ".rules",
+		titleKey:   "title.rules",
+		compact:    true,
+		maxChars:   singleFileMaxChars,
+	}}
+}
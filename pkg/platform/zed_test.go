@@ -0,0 +1,20 @@
+package platform
+
+import "testing"
+
+func TestZedAdapterConvert(t *testing.T) {
+	runAdapterCases(t, func() PlatformAdapter { return NewZedAdapter() }, []adapterCase{
+		{
+			name:     "紧凑格式省略规则描述",
+			paths:    []string{".rules"},
+			contains: map[string][]string{".rules": {"# demo Rules", GeneratedMarker, "Never hardcode secrets.", "services/api/**/*.go"}},
+			excludes: map[string][]string{".rules": {"HTTP handler conventions", "Naming conventions", "Never shown."}},
+		},
+		{
+			name:     "超出字符数阈值时警告",
+			options:  map[string]string{"max_chars": "100"},
+			paths:    []string{".rules"},
+			warnings: 1,
+		},
+	})
+}
@@ -0,0 +1,196 @@
+package rules
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// 规则来源，对应 .ruler 下的子目录，也是 rule_priority 的合法取值
+const (
+	SourceProject   = "project"
+	SourceGlobal    = "global"
+	SourceTemplates = "templates"
+)
+
+// 环境变量覆盖项
+// 优先级：命令行参数 > 环境变量 > config.yaml > 内置默认值
+const (
+	// EnvPlatform 覆盖 default_platform
+	EnvPlatform = "PF_RULER_PLATFORM"
+
+	// EnvLang 覆盖 output_language
+	EnvLang = "PF_RULER_LANG"
+
+	// envPrefix / envOutputSuffix 组成平台输出路径覆盖变量，如 PF_RULER_TRAE_OUTPUT
+	envPrefix       = "PF_RULER_"
+	envOutputSuffix = "_OUTPUT"
+)
+
+// ConfigFileName 配置文件名
+const ConfigFileName = "config.yaml"
+
+// Config .ruler/config.yaml 配置
+type Config struct {
+	// 默认生成平台，支持逗号分隔的多个平台或 all；为空时使用 ai_editors
+	DefaultPlatform string `yaml:"default_platform,omitempty"`
+
+	// 规则来源优先级，决定输出中各规则分组的顺序；未列出的来源不会输出
+	RulePriority []string `yaml:"rule_priority"`
+
+	// 生成文件的输出语言（zh-CN、en），为空时各平台使用默认语言
+	OutputLanguage string `yaml:"output_language,omitempty"`
+
+	// 平台级配置，键为平台名称
+	Platforms map[string]PlatformConfig `yaml:"platforms,omitempty"`
+
+	// 最后初始化时间
+	LastInitTime string `yaml:"last_init_time,omitempty"`
+
+	// 只由 PF_RULER_<PLATFORM>_OUTPUT 环境变量添加的平台，键为平台名称，值为环境变量名
+	envPlatforms map[string]string
+}
+
+// PlatformConfig 单个平台的配置
+type PlatformConfig struct {
+	// 输出路径，覆盖适配器的默认输出路径
+	Output string `yaml:"output,omitempty"`
+
+	// 平台选项，由对应的适配器解析和校验
+	Options map[string]string `yaml:"options,omitempty"`
+}
+
+// DefaultConfig 返回默认配置
+func DefaultConfig() *Config {
+	return &Config{
+		RulePriority: []string{SourceProject, SourceGlobal, SourceTemplates},
+	}
+}
+
+// LoadConfig 加载并校验 basePath 下的 config.yaml
+// 文件不存在时返回默认配置；包含未知配置项时返回错误
+func LoadConfig(basePath string) (*Config, error) {
+	configPath := filepath.Join(basePath, ConfigFileName)
+
+	data, err := os.ReadFile(configPath)
+	if os.IsNotExist(err) {
+		return DefaultConfig(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("读取配置文件失败: %w", err)
+	}
+
+	config := DefaultConfig()
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(config); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("解析配置文件 %s 失败（请检查是否包含未知配置项）: %w", configPath, err)
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("配置文件 %s 无效: %w", configPath, err)
+	}
+
+	return config, nil
+}
+
+// Validate 校验配置内容，并将 output_language 规范化为支持的语言名称
+func (c *Config) Validate() error {
+	if len(c.RulePriority) == 0 {
+		return fmt.Errorf("rule_priority 不能为空，可选值：%s, %s, %s",
+			SourceProject, SourceGlobal, SourceTemplates)
+	}
+
+	seen := make(map[string]bool)
+	for _, source := range c.RulePriority {
+		switch source {
+		case SourceProject, SourceGlobal, SourceTemplates:
+		default:
+			return fmt.Errorf("rule_priority 包含未知来源 \"%s\"，可选值：%s, %s, %s",
+				source, SourceProject, SourceGlobal, SourceTemplates)
+		}
+		if seen[source] {
+			return fmt.Errorf("rule_priority 中 \"%s\" 重复", source)
+		}
+		seen[source] = true
+	}
+
+	if c.OutputLanguage != "" {
+		lang, err := NormalizeLanguage(c.OutputLanguage)
+		if err != nil {
+			return fmt.Errorf("output_language 无效: %w", err)
+		}
+		c.OutputLanguage = lang
+	}
+
+	for name, platform := range c.Platforms {
+		if strings.TrimSpace(name) == "" {
+			return fmt.Errorf("platforms 中存在空的平台名称")
+		}
+		if platform.Output != "" && strings.TrimSpace(platform.Output) == "" {
+			return fmt.Errorf("platforms.%s.output 不能为空白", name)
+		}
+	}
+
+	return nil
+}
+
+// ApplyEnv 使用环境变量覆盖配置
+// PF_RULER_PLATFORM 覆盖 default_platform，PF_RULER_LANG 覆盖 output_language，PF_RULER_<PLATFORM>_OUTPUT 覆盖对应平台的输出路径
+func (c *Config) ApplyEnv() {
+	if value, ok := os.LookupEnv(EnvPlatform); ok && value != "" {
+		c.DefaultPlatform = value
+	}
+	if value, ok := os.LookupEnv(EnvLang); ok && value != "" {
+		c.OutputLanguage = value
+	}
+
+	for _, env := range os.Environ() {
+		key, value, found := strings.Cut(env, "=")
+		if !found || value == "" {
+			continue
+		}
+		if !strings.HasPrefix(key, envPrefix) || !strings.HasSuffix(key, envOutputSuffix) {
+			continue
+		}
+
+		name := strings.ToLower(strings.TrimSuffix(strings.TrimPrefix(key, envPrefix), envOutputSuffix))
+		if name == "" {
+			continue
+		}
+		if _, exists := c.Platforms[name]; !exists {
+			if c.envPlatforms == nil {
+				c.envPlatforms = make(map[string]string)
+			}
+			c.envPlatforms[name] = key
+		}
+		c.SetOutput(name, value)
+	}
+}
+
+// OutputEnv 返回添加该平台配置的环境变量名，平台在 config.yaml 中已配置时返回空字符串
+// 这类平台可能只是其他工具共用的环境变量，平台不存在时应忽略而不是报告配置文件错误
+func (c *Config) OutputEnv(platform string) string {
+	return c.envPlatforms[platform]
+}
+
+// SetOutput 设置平台输出路径
+func (c *Config) SetOutput(platform, output string) {
+	if c.Platforms == nil {
+		c.Platforms = make(map[string]PlatformConfig)
+	}
+	platformConfig := c.Platforms[platform]
+	platformConfig.Output = output
+	c.Platforms[platform] = platformConfig
+}
+
+// OutputPath 返回平台配置的输出路径，未配置时返回空字符串
+func (c *Config) OutputPath(platform string) string {
+	return c.Platforms[platform].Output
+}
@@ -0,0 +1,135 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// 输出语言，决定生成文件中标题、标签和说明文字使用的语言
+const (
+	LangZhCN = "zh-CN"
+	LangEN   = "en"
+)
+
+// SupportedLanguages 支持的输出语言
+var SupportedLanguages = []string{LangZhCN, LangEN}
+
+// NormalizeLanguage 将语言名称规范化为支持的输出语言，如 zh、zh_cn 规范化为 zh-CN
+func NormalizeLanguage(lang string) (string, error) {
+	switch strings.ToLower(strings.ReplaceAll(strings.TrimSpace(lang), "_", "-")) {
+	case "zh", "zh-cn", "zh-hans", "cn":
+		return LangZhCN, nil
+	case "en", "en-us", "en-gb":
+		return LangEN, nil
+	}
+	return "", fmt.Errorf("不支持的输出语言 \"%s\"，可选值：%s", lang, strings.Join(SupportedLanguages, ", "))
+}
+
+// RuleTranslation 规则在某种语言下的标题、描述和内容，为空的字段沿用规则原文
+type RuleTranslation struct {
+	Title       string `yaml:"title,omitempty" json:"title,omitempty"`
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+	Content     string `yaml:"content,omitempty" json:"content,omitempty"`
+}
+
+// translationMarker 规则正文中指定其他语言版本的注释，如：
+//
+//	<!-- title_en: Error Handling -->
+//	<!-- description_en: How errors are returned -->
+//	<!-- content_en -->
+//
+// content 标记之后到下一个 content 标记之前的内容为该语言的规则内容
+var translationMarker = regexp.MustCompile(`^<!--\s*(title|description|content)_([A-Za-z]{2}(?:[-_][A-Za-z]+)?)\s*(?::\s*(.*?))?\s*-->$`)
+
+// extractTranslations 从规则正文的各行中提取其他语言版本，返回原文各行和按语言划分的翻译
+// 无法识别的语言仍会被移出正文，但不会在输出中使用
+func extractTranslations(lines []string) ([]string, map[string]RuleTranslation) {
+	var original []string
+	translations := make(map[string]RuleTranslation)
+	contents := make(map[string][]string)
+	current := ""
+
+	for _, line := range lines {
+		match := translationMarker.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			if current == "" {
+				original = append(original, line)
+			} else {
+				contents[current] = append(contents[current], line)
+			}
+			continue
+		}
+
+		lang, err := NormalizeLanguage(match[2])
+		if err != nil {
+			lang = strings.ToLower(match[2])
+		}
+		translation := translations[lang]
+		switch match[1] {
+		case "title":
+			translation.Title = match[3]
+		case "description":
+			translation.Description = match[3]
+		case "content":
+			current = lang
+		}
+		translations[lang] = translation
+	}
+
+	for lang, lines := range contents {
+		translation := translations[lang]
+		translation.Content = strings.TrimSpace(strings.Join(lines, "\n"))
+		translations[lang] = translation
+	}
+
+	if len(translations) == 0 {
+		return original, nil
+	}
+	return original, translations
+}
+
+// Localize 返回使用指定语言版本的规则集副本：规则带有该语言的翻译时替换对应字段，并记录输出语言
+func (rs *RuleSet) Localize(lang string) *RuleSet {
+	localize := func(ruleList []Rule) []Rule {
+		if ruleList == nil {
+			return nil
+		}
+		result := make([]Rule, len(ruleList))
+		for i, rule := range ruleList {
+			if translation, ok := rule.translation(lang); ok {
+				if translation.Title != "" {
+					rule.Title = translation.Title
+				}
+				if translation.Description != "" {
+					rule.Description = translation.Description
+				}
+				if translation.Content != "" {
+					rule.Content = translation.Content
+				}
+			}
+			result[i] = rule
+		}
+		return result
+	}
+
+	localized := *rs
+	localized.ProjectRules = localize(rs.ProjectRules)
+	localized.GlobalRules = localize(rs.GlobalRules)
+	localized.TemplateRules = localize(rs.TemplateRules)
+	localized.Language = lang
+	return &localized
+}
+
+// translation 返回规则在指定语言下的翻译，YAML 规则文件中的语言名称（如 zh、en-US）按规范化后的名称匹配
+func (r Rule) translation(lang string) (RuleTranslation, bool) {
+	if translation, ok := r.Translations[lang]; ok {
+		return translation, true
+	}
+	for name, translation := range r.Translations {
+		if normalized, err := NormalizeLanguage(name); err == nil && normalized == lang {
+			return translation, true
+		}
+	}
+	return RuleTranslation{}, false
+}
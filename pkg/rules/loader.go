@@ -1,7 +1,9 @@
 package rules
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -82,8 +84,6 @@ func (l *FileLoader) LoadProjectRules() ([]Rule, error) {
 			Priority:    5,
 			Enabled:     true,
 			Tags:        []string{"tech", "stack"},
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
 		})
 	}
 
@@ -103,8 +103,6 @@ func (l *FileLoader) LoadProjectRules() ([]Rule, error) {
 			Priority:    4,
 			Enabled:     true,
 			Tags:        []string{"code", "style", "naming"},
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
 		})
 
 		rules = append(rules, Rule{
@@ -115,8 +113,6 @@ func (l *FileLoader) LoadProjectRules() ([]Rule, error) {
 			Priority:    5,
 			Enabled:     true,
 			Tags:        []string{"security", "encryption"},
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
 		})
 	}
 
@@ -150,7 +146,10 @@ func (l *FileLoader) loadProjectFiles(projectDir string) ([]Rule, error) {
 		}
 
 		// 解析 Markdown 文件内容，提取规则
-		rules := l.parseMarkdownRules(string(content), file.Name())
+		rules, err := l.parseMarkdownRules(string(content), file.Name())
+		if err != nil {
+			return nil, err
+		}
 		allRules = append(allRules, rules...)
 	}
 
@@ -218,7 +217,8 @@ func createRuleFromSection(sectionName string, content []string) *Rule {
 	// 根据章节名推断规则类型和标签
 	ruleType, tags := inferRuleTypeAndTags(sectionName)
 
-	// 合并内容
+	// 合并内容，其他语言版本单独保存
+	content, translations := extractTranslations(content)
 	contentText := strings.Join(content, "\n")
 
 	// 设置优先级（根据章节类型）
@@ -228,15 +228,14 @@ func createRuleFromSection(sectionName string, content []string) *Rule {
 	}
 
 	return &Rule{
-		Title:       sectionName,
-		Description: fmt.Sprintf("项目 %s 相关的要求和规范", sectionName),
-		Type:        ruleType,
-		Content:     contentText,
-		Priority:    priority,
-		Enabled:     true,
-		Tags:        tags,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		Title:        sectionName,
+		Description:  fmt.Sprintf("项目 %s 相关的要求和规范", sectionName),
+		Type:         ruleType,
+		Content:      contentText,
+		Priority:     priority,
+		Enabled:      true,
+		Tags:         tags,
+		Translations: translations,
 	}
 }
 
@@ -354,8 +353,6 @@ func (l *FileLoader) LoadGlobalRules() ([]Rule, error) {
 			Priority:    3,
 			Enabled:     true,
 			Tags:        []string{"naming", "general"},
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
 		},
 		{
 			Title:       "代码注释规范",
@@ -365,8 +362,6 @@ func (l *FileLoader) LoadGlobalRules() ([]Rule, error) {
 			Priority:    3,
 			Enabled:     true,
 			Tags:        []string{"documentation", "comments"},
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
 		},
 		{
 			Title:       "错误处理规范",
@@ -376,8 +371,6 @@ func (l *FileLoader) LoadGlobalRules() ([]Rule, error) {
 			Priority:    4,
 			Enabled:     true,
 			Tags:        []string{"error", "handling"},
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
 		},
 	}
 
@@ -413,17 +406,79 @@ func (l *FileLoader) loadGlobalFiles(globalDir string) ([]Rule, error) {
 		}
 
 		// 解析 Markdown 文件内容，提取规则
-		rules := l.parseMarkdownRules(string(content), file.Name())
+		rules, err := l.parseMarkdownRules(string(content), file.Name())
+		if err != nil {
+			return nil, err
+		}
 		allRules = append(allRules, rules...)
 	}
 
 	return allRules, nil
 }
 
+// ruleFileFrontMatter 规则文件开头的 YAML front matter，作用于文件中的所有规则
+//
+//	---
+//	globs: ["src/**/*.ts"]
+//	tags: ["frontend"]
+//	priority: 5
+//	---
+type ruleFileFrontMatter struct {
+	// 规则作用范围
+	Globs []string `yaml:"globs"`
+
+	// 追加到推断标签之后的标签
+	Tags []string `yaml:"tags"`
+
+	// 覆盖默认优先级（1-5）
+	Priority int `yaml:"priority"`
+}
+
+// splitFrontMatter 拆分 Markdown 文件开头的 front matter，返回解析结果和剩余正文
+func splitFrontMatter(content, filename string) (ruleFileFrontMatter, string, error) {
+	var frontMatter ruleFileFrontMatter
+
+	normalized := strings.ReplaceAll(content, "\r\n", "\n")
+	if !strings.HasPrefix(normalized, "---\n") {
+		return frontMatter, content, nil
+	}
+
+	end := strings.Index(normalized[4:], "\n---")
+	if end < 0 {
+		return frontMatter, content, fmt.Errorf("%s 的 front matter 缺少结束标记 ---", filename)
+	}
+
+	header := normalized[4 : 4+end]
+	body := normalized[4+end+len("\n---"):]
+
+	decoder := yaml.NewDecoder(strings.NewReader(header))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&frontMatter); err != nil && !errors.Is(err, io.EOF) {
+		return frontMatter, content, fmt.Errorf("解析 %s 的 front matter 失败: %w", filename, err)
+	}
+
+	if frontMatter.Priority < 0 || frontMatter.Priority > 5 {
+		return frontMatter, content, fmt.Errorf("%s 的 front matter 中 priority 必须在 1-5 之间", filename)
+	}
+
+	return frontMatter, body, nil
+}
+
 // parseMarkdownRules 解析 Markdown 文件内容，提取规则
-func (l *FileLoader) parseMarkdownRules(content, filename string) []Rule {
+// 文件开头可以包含 front matter，为文件中的所有规则指定作用范围、标签和优先级
+func (l *FileLoader) parseMarkdownRules(content, filename string) ([]Rule, error) {
 	var rules []Rule
 
+	frontMatter, content, err := splitFrontMatter(content, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	priority := 4 // 默认优先级
+	if frontMatter.Priority > 0 {
+		priority = frontMatter.Priority
+	}
+
 	lines := strings.Split(content, "\n")
 	var currentRule *Rule
 	var currentContent []string
@@ -435,13 +490,7 @@ func (l *FileLoader) parseMarkdownRules(content, filename string) []Rule {
 		if strings.HasPrefix(trimmedLine, "## ") {
 			// 保存前一个规则（即使内容为空也保存）
 			if currentRule != nil {
-				if len(currentContent) > 0 {
-					currentRule.Content = strings.Join(currentContent, "\n")
-				} else {
-					// 如果内容为空，设置默认内容
-					currentRule.Content = "（无详细说明）"
-				}
-				rules = append(rules, *currentRule)
+				rules = append(rules, finishMarkdownRule(currentRule, currentContent))
 			}
 
 			// 创建新规则
@@ -450,11 +499,10 @@ func (l *FileLoader) parseMarkdownRules(content, filename string) []Rule {
 				Title:       title,
 				Description: fmt.Sprintf("来自 %s 的规则", filename),
 				Type:        l.inferRuleType(title),
-				Priority:    4, // 默认优先级
+				Priority:    priority,
 				Enabled:     true,
-				Tags:        l.inferTags(title, filename),
-				CreatedAt:   time.Now(),
-				UpdatedAt:   time.Now(),
+				Tags:        append(l.inferTags(title, filename), frontMatter.Tags...),
+				Globs:       frontMatter.Globs,
 			}
 			currentContent = []string{}
 		} else if currentRule != nil {
@@ -468,15 +516,21 @@ func (l *FileLoader) parseMarkdownRules(content, filename string) []Rule {
 
 	// 保存最后一个规则
 	if currentRule != nil {
-		if len(currentContent) > 0 {
-			currentRule.Content = strings.Join(currentContent, "\n")
-		} else {
-			currentRule.Content = "（无详细说明）"
-		}
-		rules = append(rules, *currentRule)
+		rules = append(rules, finishMarkdownRule(currentRule, currentContent))
 	}
 
-	return rules
+	return rules, nil
+}
+
+// finishMarkdownRule 设置规则内容及其他语言版本，内容为空时使用默认内容
+func finishMarkdownRule(rule *Rule, content []string) Rule {
+	content, rule.Translations = extractTranslations(content)
+	if len(content) > 0 {
+		rule.Content = strings.Join(content, "\n")
+	} else {
+		rule.Content = "（无详细说明）"
+	}
+	return *rule
 }
 
 // inferRuleType 根据标题推断规则类型
@@ -622,8 +676,6 @@ func (l *FileLoader) generatePHPRules(tech string) []Rule {
 			Priority:    5,
 			Enabled:     true,
 			Tags:        []string{"security", "php", "sql-injection"},
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
 		},
 		{
 			Title:       "PHP性能优化",
@@ -633,8 +685,6 @@ func (l *FileLoader) generatePHPRules(tech string) []Rule {
 			Priority:    4,
 			Enabled:     true,
 			Tags:        []string{"performance", "php", "optimization"},
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
 		},
 	}
 
@@ -648,8 +698,6 @@ func (l *FileLoader) generatePHPRules(tech string) []Rule {
 			Priority:    4,
 			Enabled:     true,
 			Tags:        []string{"framework", "laravel", "best-practices"},
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
 		})
 	}
 
@@ -667,8 +715,6 @@ func (l *FileLoader) generateGoRules(tech string) []Rule {
 			Priority:    4,
 			Enabled:     true,
 			Tags:        []string{"code_style", "go", "golang"},
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
 		},
 		{
 			Title:       "Go错误处理",
@@ -678,8 +724,6 @@ func (l *FileLoader) generateGoRules(tech string) []Rule {
 			Priority:    5,
 			Enabled:     true,
 			Tags:        []string{"error_handling", "go", "best-practices"},
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
 		},
 	}
 }
@@ -695,8 +739,6 @@ func (l *FileLoader) generateJavaRules(tech string) []Rule {
 			Priority:    4,
 			Enabled:     true,
 			Tags:        []string{"code_style", "java", "spring"},
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
 		},
 	}
 }
@@ -712,8 +754,6 @@ func (l *FileLoader) generatePythonRules(tech string) []Rule {
 			Priority:    4,
 			Enabled:     true,
 			Tags:        []string{"code_style", "python", "pep8"},
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
 		},
 	}
 }
@@ -729,8 +769,6 @@ func (l *FileLoader) generateNodeRules(tech string) []Rule {
 			Priority:    5,
 			Enabled:     true,
 			Tags:        []string{"security", "nodejs", "express"},
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
 		},
 	}
 }
@@ -746,8 +784,6 @@ func (l *FileLoader) generateFrontendRules(tech string) []Rule {
 			Priority:    5,
 			Enabled:     true,
 			Tags:        []string{"security", "frontend", "xss"},
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
 		},
 	}
 }
@@ -763,8 +799,6 @@ func (l *FileLoader) generateDatabaseRules(tech string) []Rule {
 			Priority:    5,
 			Enabled:     true,
 			Tags:        []string{"security", "database", "sql-injection"},
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
 		},
 	}
 }
@@ -780,8 +814,6 @@ func (l *FileLoader) generateCacheRules(tech string) []Rule {
 			Priority:    4,
 			Enabled:     true,
 			Tags:        []string{"performance", "cache", "redis"},
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
 		},
 	}
 }
@@ -797,8 +829,6 @@ func (l *FileLoader) generateDevOpsRules(tech string) []Rule {
 			Priority:    5,
 			Enabled:     true,
 			Tags:        []string{"security", "docker", "kubernetes"},
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
 		},
 	}
 }
@@ -832,18 +862,6 @@ func (l *FileLoader) LoadMetadata() (*Metadata, error) {
 		return nil, fmt.Errorf("解析技术栈文件失败: %w", err)
 	}
 
-	// 读取配置文件
-	configPath := filepath.Join(l.basePath, "config.yaml")
-	configData, err := os.ReadFile(configPath)
-	if err != nil {
-		return nil, fmt.Errorf("读取配置文件失败: %w", err)
-	}
-
-	var config map[string]interface{}
-	if err := yaml.Unmarshal(configData, &config); err != nil {
-		return nil, fmt.Errorf("解析配置文件失败: %w", err)
-	}
-
 	// 获取技术栈信息
 	techStacks := getStringSlice(techStack, "tech_stacks")
 
@@ -864,12 +882,10 @@ func (l *FileLoader) LoadMetadata() (*Metadata, error) {
 	}
 
 	metadata := &Metadata{
-		ProjectName:   getString(techStack, "project_name", "未知项目"),
-		TechStacks:    techStacks,
-		AIEditors:     getStringSlice(techStack, "ai_editors"),
-		CreatedAt:     time.Now(),
-		LastUpdatedAt: time.Now(),
-		Version:       "1.1.0",
+		ProjectName: getString(techStack, "project_name", "未知项目"),
+		TechStacks:  techStacks,
+		AIEditors:   getStringSlice(techStack, "ai_editors"),
+		Version:     "1.1.0",
 	}
 
 	return metadata, nil
@@ -929,20 +945,37 @@ func extractTechStacksFromRequirements(content string) []string {
 }
 
 // LoadAllRules 加载所有规则
+// 只加载 rule_priority 中列出的规则来源，并按其顺序记录到 SourceOrder
 func (l *FileLoader) LoadAllRules() (*RuleSet, error) {
-	projectRules, err := l.LoadProjectRules()
-	if err != nil {
-		return nil, fmt.Errorf("加载项目规则失败: %w", err)
-	}
-
-	globalRules, err := l.LoadGlobalRules()
-	if err != nil {
-		return nil, fmt.Errorf("加载全局规则失败: %w", err)
+	config := l.config
+	if config == nil {
+		loaded, err := LoadConfig(l.basePath)
+		if err != nil {
+			return nil, fmt.Errorf("加载配置失败: %w", err)
+		}
+		config = loaded
 	}
 
-	templateRules, err := l.LoadTemplateRules()
-	if err != nil {
-		return nil, fmt.Errorf("加载模板规则失败: %w", err)
+	var projectRules, globalRules, templateRules []Rule
+	for _, source := range config.RulePriority {
+		var err error
+		switch source {
+		case SourceProject:
+			projectRules, err = l.LoadProjectRules()
+			if err != nil {
+				return nil, fmt.Errorf("加载项目规则失败: %w", err)
+			}
+		case SourceGlobal:
+			globalRules, err = l.LoadGlobalRules()
+			if err != nil {
+				return nil, fmt.Errorf("加载全局规则失败: %w", err)
+			}
+		case SourceTemplates:
+			templateRules, err = l.LoadTemplateRules()
+			if err != nil {
+				return nil, fmt.Errorf("加载模板规则失败: %w", err)
+			}
+		}
 	}
 
 	metadata, err := l.LoadMetadata()
@@ -955,11 +988,33 @@ func (l *FileLoader) LoadAllRules() (*RuleSet, error) {
 		GlobalRules:   globalRules,
 		TemplateRules: templateRules,
 		Metadata:      *metadata,
+		SourceOrder:   config.RulePriority,
 	}
+	ruleSet.stampTime(SourceTime())
 
 	return ruleSet, nil
 }
 
+// stampTime 将规则和元数据中未设置的时间设为 SourceTime，使相同的输入得到相同的规则集
+func (rs *RuleSet) stampTime(sourceTime time.Time) {
+	for _, ruleList := range [][]Rule{rs.ProjectRules, rs.GlobalRules, rs.TemplateRules} {
+		for i := range ruleList {
+			if ruleList[i].CreatedAt.IsZero() {
+				ruleList[i].CreatedAt = sourceTime
+			}
+			if ruleList[i].UpdatedAt.IsZero() {
+				ruleList[i].UpdatedAt = sourceTime
+			}
+		}
+	}
+	if rs.Metadata.CreatedAt.IsZero() {
+		rs.Metadata.CreatedAt = sourceTime
+	}
+	if rs.Metadata.LastUpdatedAt.IsZero() {
+		rs.Metadata.LastUpdatedAt = sourceTime
+	}
+}
+
 // 辅助函数
 func getString(data map[string]interface{}, key, defaultValue string) string {
 	if value, exists := data[key]; exists {
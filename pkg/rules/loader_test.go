@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitFrontMatter(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		frontMatter ruleFileFrontMatter
+		body        string
+		wantErr     bool
+	}{
+		{
+			name:    "没有 front matter",
+			content: "## 规则\n内容\n",
+			body:    "## 规则\n内容\n",
+		},
+		{
+			name:    "完整的 front matter",
+			content: "---\nglobs: [\"src/**/*.ts\"]\ntags: [frontend]\npriority: 5\n---\n## 规则\n",
+			frontMatter: ruleFileFrontMatter{
+				Globs:    []string{"src/**/*.ts"},
+				Tags:     []string{"frontend"},
+				Priority: 5,
+			},
+			body: "\n## 规则\n",
+		},
+		{
+			name:        "CRLF 换行",
+			content:     "---\r\ntags: [api]\r\n---\r\n## 规则\r\n",
+			frontMatter: ruleFileFrontMatter{Tags: []string{"api"}},
+			body:        "\n## 规则\n",
+		},
+		{
+			name:    "空的 front matter",
+			content: "---\n\n---\n## 规则\n",
+			body:    "\n## 规则\n",
+		},
+		{
+			name:    "缺少结束标记",
+			content: "---\nglobs: [\"*.go\"]\n## 规则\n",
+			wantErr: true,
+		},
+		{
+			name:    "未知字段",
+			content: "---\nglob: \"*.go\"\n---\n## 规则\n",
+			wantErr: true,
+		},
+		{
+			name:    "priority 超出范围",
+			content: "---\npriority: 6\n---\n## 规则\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			frontMatter, body, err := splitFrontMatter(tt.content, "rules.md")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("期望返回错误，实际为 nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("意外的错误: %v", err)
+			}
+			if !reflect.DeepEqual(frontMatter, tt.frontMatter) {
+				t.Errorf("front matter = %+v，期望 %+v", frontMatter, tt.frontMatter)
+			}
+			if body != tt.body {
+				t.Errorf("正文 = %q，期望 %q", body, tt.body)
+			}
+		})
+	}
+}
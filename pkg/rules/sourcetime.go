@@ -0,0 +1,24 @@
+package rules
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// EnvSourceDateEpoch 指定规则时间的 Unix 时间戳，与 reproducible-builds.org 的约定一致
+const EnvSourceDateEpoch = "SOURCE_DATE_EPOCH"
+
+// SourceTime 返回 SOURCE_DATE_EPOCH 指定的时间，用于代替生成时的当前时间；未设置或无效时返回零值
+// 不使用 git 提交时间或文件修改时间：提交重新生成的文件会改变这些时间，导致 generate --check 立即失败
+func SourceTime() time.Time {
+	value := os.Getenv(EnvSourceDateEpoch)
+	if value == "" {
+		return time.Time{}
+	}
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0).UTC()
+}
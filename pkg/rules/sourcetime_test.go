@@ -0,0 +1,27 @@
+package rules
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSourceTime(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  time.Time
+	}{
+		{name: "未设置", value: ""},
+		{name: "有效的时间戳", value: "1577836800", want: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "无效的时间戳", value: "2020-01-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvSourceDateEpoch, tt.value)
+			if got := SourceTime(); !got.Equal(tt.want) {
+				t.Errorf("SourceTime() = %v，期望 %v", got, tt.want)
+			}
+		})
+	}
+}
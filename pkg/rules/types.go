@@ -17,6 +17,49 @@ type RuleSet struct {
 
 	// 元数据
 	Metadata Metadata `yaml:"metadata" json:"metadata"`
+
+	// 规则来源顺序（来自 config.yaml 的 rule_priority）
+	SourceOrder []string `yaml:"source_order" json:"source_order"`
+
+	// 输出语言（zh-CN、en），为空时各平台使用默认语言
+	Language string `yaml:"language,omitempty" json:"language,omitempty"`
+}
+
+// RuleSection 按来源分组的规则
+type RuleSection struct {
+	// 规则来源（project、global、templates）
+	Source string
+
+	// 该来源下的规则
+	Rules []Rule
+}
+
+// Sections 按 SourceOrder 返回非空的规则分组
+// SourceOrder 为空时使用默认顺序：project、global、templates
+func (rs *RuleSet) Sections() []RuleSection {
+	order := rs.SourceOrder
+	if len(order) == 0 {
+		order = DefaultConfig().RulePriority
+	}
+
+	sections := make([]RuleSection, 0, len(order))
+	for _, source := range order {
+		var sourceRules []Rule
+		switch source {
+		case SourceProject:
+			sourceRules = rs.ProjectRules
+		case SourceGlobal:
+			sourceRules = rs.GlobalRules
+		case SourceTemplates:
+			sourceRules = rs.TemplateRules
+		}
+
+		if len(sourceRules) > 0 {
+			sections = append(sections, RuleSection{Source: source, Rules: sourceRules})
+		}
+	}
+
+	return sections
 }
 
 // Rule 单条规则
@@ -42,11 +85,17 @@ type Rule struct {
 	// 标签（用于分类和搜索）
 	Tags []string `yaml:"tags" json:"tags"`
 
+	// 作用范围（文件 glob，如 "**/*.go"），为空表示适用于整个项目
+	Globs []string `yaml:"globs" json:"globs"`
+
 	// 创建时间
 	CreatedAt time.Time `yaml:"created_at" json:"created_at"`
 
 	// 更新时间
 	UpdatedAt time.Time `yaml:"updated_at" json:"updated_at"`
+
+	// 其他语言版本，键为输出语言
+	Translations map[string]RuleTranslation `yaml:"translations,omitempty" json:"translations,omitempty"`
 }
 
 // Metadata 规则元数据
@@ -88,6 +137,7 @@ type Loader interface {
 // FileLoader 基于文件的规则加载器
 type FileLoader struct {
 	basePath string
+	config   *Config
 }
 
 // NewFileLoader 创建新的文件加载器
@@ -96,3 +146,9 @@ func NewFileLoader(basePath string) *FileLoader {
 		basePath: basePath,
 	}
 }
+
+// WithConfig 使用已加载的配置，避免重复读取 config.yaml
+func (l *FileLoader) WithConfig(config *Config) *FileLoader {
+	l.config = config
+	return l
+}